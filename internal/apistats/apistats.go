@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apistats records per-resource AWS API usage and writes a JSON summary artifact.
+//
+// Recording is enabled by setting the TF_AWS_DIAGNOSTICS_SUMMARY_PATH environment variable
+// to the path of the file to write. Statistics are only kept in memory while the provider runs;
+// the provider writes them once, with Write, when it shuts down. Each provider process writes
+// its own file, named by inserting the process ID before the path's extension.
+package apistats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnvVar is the environment variable that enables recording and sets the summary artifact's path.
+const EnvVar = "TF_AWS_DIAGNOSTICS_SUMMARY_PATH"
+
+// ProviderTypeName is the key under which API calls not made on behalf of a resource or data source,
+// for example while configuring the provider, are summarized.
+const ProviderTypeName = "provider"
+
+// Summary is the JSON summary artifact.
+type Summary struct {
+	GeneratedAt time.Time                 `json:"generated_at"`
+	ProcessID   int                       `json:"process_id"`
+	Resources   map[string]*ResourceStats `json:"resources"`
+}
+
+// ResourceStats summarizes the work done on behalf of a single resource or data source type.
+type ResourceStats struct {
+	APICalls         int                        `json:"api_calls"`
+	APIDurationMS    int64                      `json:"api_duration_ms"`
+	APIOperations    map[string]*OperationStats `json:"api_operations,omitempty"`
+	MaxDurationMS    int64                      `json:"max_duration_ms"`
+	Operations       map[string]*HandlerStats   `json:"operations,omitempty"`
+	OperationsFailed int                        `json:"operations_failed"`
+	Retries          int                        `json:"retries"`
+	ThrottleEvents   int                        `json:"throttle_events"`
+	TotalDurationMS  int64                      `json:"total_duration_ms"`
+}
+
+// OperationStats summarizes the calls made to a single AWS API operation, e.g. "EC2.RunInstances".
+type OperationStats struct {
+	Calls          int   `json:"calls"`
+	DurationMS     int64 `json:"duration_ms"`
+	Errors         int   `json:"errors"`
+	Retries        int   `json:"retries"`
+	ThrottleEvents int   `json:"throttle_events"`
+}
+
+// HandlerStats summarizes the calls made to a single resource or data source handler, e.g. "create".
+type HandlerStats struct {
+	Count         int   `json:"count"`
+	DurationMS    int64 `json:"duration_ms"`
+	Failed        int   `json:"failed"`
+	MaxDurationMS int64 `json:"max_duration_ms"`
+}
+
+// APICall describes a single, possibly retried, AWS API call.
+type APICall struct {
+	Duration       time.Duration
+	Failed         bool
+	Operation      string
+	Retries        int
+	Service        string
+	ThrottleEvents int
+}
+
+type recorder struct {
+	mu        sync.Mutex
+	path      string
+	resources map[string]*ResourceStats
+}
+
+var (
+	defaultRecorder     *recorder
+	defaultRecorderOnce sync.Once
+)
+
+func getRecorder() *recorder {
+	defaultRecorderOnce.Do(func() {
+		if path := os.Getenv(EnvVar); path != "" {
+			defaultRecorder = newRecorder(processPath(path, os.Getpid()))
+		}
+	})
+
+	return defaultRecorder
+}
+
+// processPath returns the summary artifact's path for the specified process,
+// e.g. "summary.json" becomes "summary.1234.json".
+func processPath(path string, pid int) string {
+	ext := filepath.Ext(path)
+
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(path, ext), pid, ext)
+}
+
+func newRecorder(path string) *recorder {
+	return &recorder{
+		path:      path,
+		resources: make(map[string]*ResourceStats),
+	}
+}
+
+// Enabled returns whether recording is enabled.
+func Enabled() bool {
+	return getRecorder() != nil
+}
+
+type contextKeyType int
+
+const (
+	typeNameKey contextKeyType = iota
+	startTimeKey
+)
+
+// NewContext returns a Context that attributes AWS API calls to the specified resource type.
+// Data source types should be prefixed with "data.".
+func NewContext(ctx context.Context, typeName string) context.Context {
+	if !Enabled() {
+		return ctx
+	}
+
+	return context.WithValue(ctx, typeNameKey, typeName)
+}
+
+// TypeNameFromContext returns the resource type that AWS API calls are attributed to.
+func TypeNameFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(typeNameKey).(string); ok && v != "" {
+		return v
+	}
+
+	return ProviderTypeName
+}
+
+// RecordAPICall records an AWS API call made on behalf of the resource type in Context.
+func RecordAPICall(ctx context.Context, call APICall) {
+	if r := getRecorder(); r != nil {
+		r.recordAPICall(TypeNameFromContext(ctx), call)
+	}
+}
+
+// RecordThrottleEvent records a throttled attempt of an AWS API call made on behalf of the resource type in Context.
+// It is used when throttled attempts cannot be reported along with the call itself.
+func RecordThrottleEvent(ctx context.Context, service, operation string) {
+	if r := getRecorder(); r != nil {
+		r.recordThrottleEvent(TypeNameFromContext(ctx), service, operation)
+	}
+}
+
+// StartOperation returns a Context that records the start of a resource or data source handler.
+func StartOperation(ctx context.Context) context.Context {
+	if !Enabled() {
+		return ctx
+	}
+
+	return context.WithValue(ctx, startTimeKey, time.Now())
+}
+
+// EndOperation records the completion of the resource or data source handler started with StartOperation.
+func EndOperation(ctx context.Context, operation string, failed bool) {
+	r := getRecorder()
+	if r == nil {
+		return
+	}
+
+	start, ok := ctx.Value(startTimeKey).(time.Time)
+	if !ok {
+		return
+	}
+
+	r.recordOperation(TypeNameFromContext(ctx), operation, time.Since(start), failed)
+}
+
+// Write writes the summary artifact for this process. It is called once, when the provider shuts down.
+func Write() error {
+	if r := getRecorder(); r != nil {
+		return r.write()
+	}
+
+	return nil
+}
+
+func (r *recorder) resourceStats(typeName string) *ResourceStats {
+	v, ok := r.resources[typeName]
+	if !ok {
+		v = &ResourceStats{
+			APIOperations: make(map[string]*OperationStats),
+			Operations:    make(map[string]*HandlerStats),
+		}
+		r.resources[typeName] = v
+	}
+
+	return v
+}
+
+func (s *ResourceStats) apiOperationStats(service, operation string) *OperationStats {
+	key := service + "." + operation
+	v, ok := s.APIOperations[key]
+	if !ok {
+		v = &OperationStats{}
+		s.APIOperations[key] = v
+	}
+
+	return v
+}
+
+func (r *recorder) recordAPICall(typeName string, call APICall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resource := r.resourceStats(typeName)
+	resource.APICalls++
+	resource.APIDurationMS += call.Duration.Milliseconds()
+	resource.Retries += call.Retries
+	resource.ThrottleEvents += call.ThrottleEvents
+
+	operation := resource.apiOperationStats(call.Service, call.Operation)
+	operation.Calls++
+	operation.DurationMS += call.Duration.Milliseconds()
+	operation.Retries += call.Retries
+	operation.ThrottleEvents += call.ThrottleEvents
+	if call.Failed {
+		operation.Errors++
+	}
+}
+
+func (r *recorder) recordThrottleEvent(typeName, service, operation string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resource := r.resourceStats(typeName)
+	resource.ThrottleEvents++
+	resource.apiOperationStats(service, operation).ThrottleEvents++
+}
+
+func (r *recorder) recordOperation(typeName, operation string, duration time.Duration, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ms := duration.Milliseconds()
+
+	resource := r.resourceStats(typeName)
+	resource.TotalDurationMS += ms
+	resource.MaxDurationMS = max(resource.MaxDurationMS, ms)
+	if failed {
+		resource.OperationsFailed++
+	}
+
+	handler, ok := resource.Operations[operation]
+	if !ok {
+		handler = &HandlerStats{}
+		resource.Operations[operation] = handler
+	}
+	handler.Count++
+	handler.DurationMS += ms
+	handler.MaxDurationMS = max(handler.MaxDurationMS, ms)
+	if failed {
+		handler.Failed++
+	}
+}
+
+// write writes the summary artifact with the current statistics.
+func (r *recorder) write() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(Summary{
+		GeneratedAt: time.Now().UTC(),
+		ProcessID:   os.Getpid(),
+		Resources:   r.resources,
+	}, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, b, 0o644)
+}
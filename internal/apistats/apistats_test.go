@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apistats
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTypeNameFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if got, want := TypeNameFromContext(ctx), ProviderTypeName; got != want {
+		t.Errorf("TypeNameFromContext = %q, want %q", got, want)
+	}
+
+	ctx = context.WithValue(ctx, typeNameKey, "aws_instance")
+
+	if got, want := TypeNameFromContext(ctx), "aws_instance"; got != want {
+		t.Errorf("TypeNameFromContext = %q, want %q", got, want)
+	}
+}
+
+func TestRecorderWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "summary.json")
+	r := newRecorder(path)
+
+	r.recordAPICall("aws_instance", APICall{
+		Duration:       300 * time.Millisecond,
+		Operation:      "RunInstances",
+		Retries:        2,
+		Service:        "EC2",
+		ThrottleEvents: 1,
+	})
+	r.recordAPICall("aws_instance", APICall{
+		Duration:  100 * time.Millisecond,
+		Failed:    true,
+		Operation: "DescribeInstances",
+		Service:   "EC2",
+	})
+	r.recordThrottleEvent("aws_instance", "EC2", "DescribeInstances")
+	r.recordOperation("aws_instance", "create", 2*time.Second, false)
+	r.recordOperation("aws_instance", "create", 3*time.Second, true)
+	r.recordAPICall(ProviderTypeName, APICall{
+		Duration:  50 * time.Millisecond,
+		Operation: "GetCallerIdentity",
+		Service:   "STS",
+	})
+
+	if err := r.write(); err != nil {
+		t.Fatalf("writing summary: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading summary: %s", err)
+	}
+
+	var got Summary
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("decoding summary: %s", err)
+	}
+
+	want := map[string]*ResourceStats{
+		"aws_instance": {
+			APICalls:      2,
+			APIDurationMS: 400,
+			APIOperations: map[string]*OperationStats{
+				"EC2.DescribeInstances": {
+					Calls:          1,
+					DurationMS:     100,
+					Errors:         1,
+					ThrottleEvents: 1,
+				},
+				"EC2.RunInstances": {
+					Calls:          1,
+					DurationMS:     300,
+					Retries:        2,
+					ThrottleEvents: 1,
+				},
+			},
+			MaxDurationMS: 3000,
+			Operations: map[string]*HandlerStats{
+				"create": {
+					Count:         2,
+					DurationMS:    5000,
+					Failed:        1,
+					MaxDurationMS: 3000,
+				},
+			},
+			OperationsFailed: 1,
+			Retries:          2,
+			ThrottleEvents:   2,
+			TotalDurationMS:  5000,
+		},
+		ProviderTypeName: {
+			APICalls:      1,
+			APIDurationMS: 50,
+			APIOperations: map[string]*OperationStats{
+				"STS.GetCallerIdentity": {
+					Calls:      1,
+					DurationMS: 50,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got.Resources, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if got, want := got.ProcessID, os.Getpid(); got != want {
+		t.Errorf("ProcessID = %d, want %d", got, want)
+	}
+}
+
+func TestProcessPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path string
+		want string
+	}{
+		"extension": {
+			path: "/tmp/summary.json",
+			want: "/tmp/summary.1234.json",
+		},
+		"no extension": {
+			path: "/tmp/summary",
+			want: "/tmp/summary.1234",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := processPath(testCase.path, 1234); got != testCase.want {
+				t.Errorf("processPath = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
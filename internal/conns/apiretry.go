@@ -27,3 +27,20 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// withMaxAttempts returns a Retryer which overrides the maximum number of attempts of the specified Retryer.
+func withMaxAttempts(r aws.RetryerV2, maxAttempts int) aws.RetryerV2 {
+	return &maxAttemptsRetryer{
+		RetryerV2:   r,
+		maxAttempts: maxAttempts,
+	}
+}
+
+type maxAttemptsRetryer struct {
+	aws.RetryerV2
+	maxAttempts int
+}
+
+func (r *maxAttemptsRetryer) MaxAttempts() int {
+	return r.maxAttempts
+}
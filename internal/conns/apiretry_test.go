@@ -94,3 +94,16 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestWithMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	r := withMaxAttempts(AddIsErrorRetryables(retry.NewStandard()), 50)
+
+	if got, want := r.MaxAttempts(), 50; got != want {
+		t.Errorf("MaxAttempts() = %d, want %d", got, want)
+	}
+	if got, want := r.IsErrorRetryable(errors.New("testing")), false; got != want {
+		t.Errorf("IsErrorRetryable() = %t, want %t", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
+)
+
+// withAPIStats returns an API option that records every AWS SDK for Go v2 API call in the diagnostics summary.
+func withAPIStats() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Add after the service metadata middleware so that the service and operation names are available.
+		return stack.Initialize.Add(&apiStatsMiddleware{}, middleware.After)
+	}
+}
+
+type apiStatsMiddleware struct{}
+
+func (*apiStatsMiddleware) ID() string {
+	return "TF_AWS_APIStats"
+}
+
+func (*apiStatsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	call := apistats.APICall{
+		Duration:  time.Since(start),
+		Failed:    err != nil,
+		Operation: awsmiddleware.GetOperationName(ctx),
+		Service:   awsmiddleware.GetServiceID(ctx),
+	}
+
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		call.Retries = max(len(results.Results)-1, 0)
+		call.ThrottleEvents = countThrottleEvents(results.Results)
+	}
+
+	apistats.RecordAPICall(ctx, call)
+
+	return out, metadata, err
+}
+
+func countThrottleEvents(results []retry.AttemptResult) int {
+	n := 0
+	isThrottle := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	for _, v := range results {
+		if v.Err != nil && isThrottle.IsErrorThrottle(v.Err).Bool() {
+			n++
+		}
+	}
+
+	return n
+}
+
+// apiStatsAfterRetryHandler records throttled attempts of AWS SDK for Go v1 API calls.
+// It is run after the retry decision for every attempt.
+func apiStatsAfterRetryHandler(r *request.Request) {
+	if r.Error != nil && request.IsErrorThrottle(r.Error) {
+		apistats.RecordThrottleEvent(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name)
+	}
+}
+
+// apiStatsCompleteHandler records every AWS SDK for Go v1 API call in the diagnostics summary.
+func apiStatsCompleteHandler(r *request.Request) {
+	apistats.RecordAPICall(r.Context(), apistats.APICall{
+		Duration:  time.Since(r.Time),
+		Failed:    r.Error != nil,
+		Operation: r.Operation.Name,
+		Retries:   r.RetryCount,
+		Service:   r.ClientInfo.ServiceID,
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestCountThrottleEvents(t *testing.T) {
+	t.Parallel()
+
+	errThrottling := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	errOther := &smithy.GenericAPIError{Code: "ValidationException", Message: "invalid"}
+
+	testCases := []struct {
+		name     string
+		results  []retry.AttemptResult
+		expected int
+	}{
+		{
+			name: "no attempts",
+		},
+		{
+			name:    "success",
+			results: []retry.AttemptResult{{}},
+		},
+		{
+			name: "throttled then success",
+			results: []retry.AttemptResult{
+				{Err: errThrottling, Retryable: true, Retried: true},
+				{Err: errThrottling, Retryable: true, Retried: true},
+				{},
+			},
+			expected: 2,
+		},
+		{
+			name: "other errors",
+			results: []retry.AttemptResult{
+				{Err: errors.New("connection reset"), Retryable: true, Retried: true},
+				{Err: errOther},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := countThrottleEvents(testCase.results), testCase.expected; got != want {
+				t.Errorf("countThrottleEvents = %d, want %d", got, want)
+			}
+		})
+	}
+}
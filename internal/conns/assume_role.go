@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// chainAssumeRoleCredentialsProvider returns a credentials provider that assumes the specified IAM role
+// using the credentials in the specified AWS config, i.e. the credentials of the previous role in the chain.
+func chainAssumeRoleCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, awsbaseConfig awsbase.Config, assumeRole *awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, basediag.Diagnostic) {
+	awsbaseConfig.AssumeRole = assumeRole
+
+	tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+		"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+		"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+		"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+		"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+	})
+
+	client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if awsbaseConfig.StsRegion != "" {
+			o.Region = awsbaseConfig.StsRegion
+		}
+		if awsbaseConfig.StsEndpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(awsbaseConfig.StsEndpoint)
+		}
+	})
+
+	provider := stscreds.NewAssumeRoleProvider(client, assumeRole.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = assumeRole.SessionName
+		o.Duration = assumeRole.Duration
+
+		if assumeRole.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(assumeRole.ExternalID)
+		}
+
+		if assumeRole.Policy != "" {
+			o.Policy = aws_sdkv2.String(assumeRole.Policy)
+		}
+
+		for _, v := range assumeRole.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		if assumeRole.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(assumeRole.SourceIdentity)
+		}
+
+		for k, v := range assumeRole.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+	})
+
+	// Assume the role now so that errors are reported during provider configuration.
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return aws_sdkv2.NewCredentialsCache(provider), nil
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
@@ -45,6 +46,7 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	maxRetriesPerService      map[string]int // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -52,6 +54,16 @@ type AWSClient struct {
 	stsRegion                 string // From provider configuration.
 }
 
+// ResourceDefaultTagsConfig returns the provider-level default tags configuration applicable to the resource
+// being operated on. Resources excluded from default tags have no default tags configuration.
+func (c *AWSClient) ResourceDefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
+	return c.DefaultTagsConfig
+}
+
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
 func (c *AWSClient) CredentialsProvider(context.Context) aws_sdkv2.CredentialsProvider {
 	if c.awsConfig == nil {
@@ -272,6 +284,26 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	// Per-service maximum retries override the provider-level value.
+	if v, ok := c.maxRetriesPerService[servicePackageName]; ok && c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		// Each SDK v2 client wraps its retryer with RetryMaxAttempts, so both must be overridden.
+		cfg.RetryMaxAttempts = v
+		if newRetryer := cfg.Retryer; newRetryer != nil {
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				r := newRetryer()
+				if r, ok := r.(aws_sdkv2.RetryerV2); ok {
+					return withMaxAttempts(r, v)
+				}
+				return retry_sdkv2.AddWithMaxAttempts(r, v)
+			}
+		}
+		m["aws_sdkv2_config"] = &cfg
+		if c.session != nil {
+			m["session"] = c.session.Copy(aws_sdkv1.NewConfig().WithMaxRetries(v))
+		}
+	}
+
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
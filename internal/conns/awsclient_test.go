@@ -6,6 +6,11 @@ package conns
 import (
 	"context"
 	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +186,49 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientAPIClientConfigMaxRetriesPerService(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+			Retryer: func() aws.Retryer {
+				return retry.NewStandard()
+			},
+			RetryMaxAttempts: 25,
+		},
+		maxRetriesPerService: map[string]int{
+			names.STS: 3,
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		expected           int
+	}{
+		"override": {
+			servicePackageName: names.STS,
+			expected:           3,
+		},
+		"no override": {
+			servicePackageName: names.EC2,
+			expected:           25,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := client.apiClientConfig(ctx, testCase.servicePackageName)["aws_sdkv2_config"].(*aws.Config)
+
+			// Build an SDK v2 client, which applies RetryMaxAttempts to the configured retryer.
+			if got := sts.NewFromConfig(*cfg).Options().Retryer.MaxAttempts(); got != testCase.expected {
+				t.Errorf("MaxAttempts = %d, want %d", got, testCase.expected)
+			}
+		})
+	}
+}
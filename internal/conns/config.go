@@ -6,6 +6,7 @@ package conns
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -26,23 +28,41 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	// EndpointURLModeAWS is the default endpoint URL mode, targeting AWS service endpoints.
+	EndpointURLModeAWS = "aws"
+	// EndpointURLModeCustom targets non-AWS, API-compatible endpoints such as S3-compatible object stores or LocalStack.
+	EndpointURLModeCustom = "custom"
+
+	// EndpointURLModeEnvVar is the environment variable used to set the endpoint URL mode.
+	EndpointURLModeEnvVar = "TF_AWS_ENDPOINT_URL_MODE"
+)
+
+// IsCustomEndpointURLModeFromEnv returns whether the endpoint URL mode environment variable is set to "custom".
+func IsCustomEndpointURLModeFromEnv() bool {
+	return strings.EqualFold(os.Getenv(EndpointURLModeEnvVar), EndpointURLModeCustom)
+}
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURLMode                string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPropagationTimeout          time.Duration
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MaxRetriesPerService           map[string]int
 	NoProxy                        string
 	Profile                        string
 	Region                         string
@@ -114,8 +134,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// aws-sdk-go-base assumes the first role. Any further roles are chained after the AWS config is loaded.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -159,6 +180,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.AssumeRole) > 1 {
+		for _, assumeRole := range c.AssumeRole[1:] {
+			credentialsProvider, d := chainAssumeRoleCredentialsProvider(ctx, cfg, awsbaseConfig, assumeRole)
+			if d != nil {
+				return nil, append(diags, diag.Diagnostic{
+					Severity: baseSeverityToSDKSeverity(d.Severity()),
+					Summary:  d.Summary(),
+					Detail:   d.Detail(),
+				})
+			}
+
+			cfg.Credentials = credentialsProvider
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -166,6 +202,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if c.IAMPropagationTimeout > 0 {
+		cfg.APIOptions = append(cfg.APIOptions, withIAMPropagationRetry(c.IAMPropagationTimeout))
+	}
+
+	if apistats.Enabled() {
+		cfg.APIOptions = append(cfg.APIOptions, withAPIStats())
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -183,6 +227,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if apistats.Enabled() {
+		session.Handlers.AfterRetry.PushBack(apiStatsAfterRetryHandler)
+		session.Handlers.Complete.PushBack(apiStatsCompleteHandler)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -224,6 +273,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.maxRetriesPerService = c.MaxRetriesPerService
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	iamPropagationRetryMinDelay = 2 * time.Second
+	iamPropagationRetryMaxDelay = 15 * time.Second
+)
+
+// iamPropagationErrorCodes are API error codes returned while a newly created or updated
+// IAM role or principal has not yet propagated to the calling service.
+// Bare access denied errors are not included, as they usually indicate a real lack of permissions
+// and should fail fast.
+var iamPropagationErrorCodes = []string{
+	"InvalidPrincipal",
+	"InvalidRoleException",
+}
+
+// iamPropagationErrorMessages are (lower-cased) fragments of API error messages returned by
+// services that report IAM eventual consistency as a validation error.
+// For example, AWS Lambda returns "InvalidParameterValueException: The role defined for the function cannot be assumed by Lambda."
+var iamPropagationErrorMessages = []string{
+	"cannot be assumed",
+	"invalid principal",
+	"principalnotfound",
+}
+
+// readOperationPrefixes are the prefixes of API operations that are never retried.
+// Reads commonly return access denied errors that resources deliberately tolerate.
+var readOperationPrefixes = []string{
+	"BatchDescribe",
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// withIAMPropagationRetry returns an API option that retries mutating operations for up to
+// the specified duration when they fail with an error indicating IAM eventual consistency.
+func withIAMPropagationRetry(timeout time.Duration) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Add after the service metadata middleware so that the operation name is available.
+		return stack.Initialize.Add(&iamPropagationRetryMiddleware{
+			timeout:  timeout,
+			minDelay: iamPropagationRetryMinDelay,
+			maxDelay: iamPropagationRetryMaxDelay,
+		}, middleware.After)
+	}
+}
+
+type iamPropagationRetryMiddleware struct {
+	timeout  time.Duration
+	minDelay time.Duration
+	maxDelay time.Duration
+}
+
+func (*iamPropagationRetryMiddleware) ID() string {
+	return "TF_AWS_IAMPropagationRetry"
+}
+
+func (m *iamPropagationRetryMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	deadline := time.Now().Add(m.timeout)
+	delay := m.minDelay
+
+	for {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if err == nil || !isIAMPropagationRetryable(operation, err) || time.Now().Add(delay).After(deadline) {
+			return out, metadata, err
+		}
+
+		tflog.Debug(ctx, "Retrying on possible IAM eventual consistency error", map[string]any{
+			"aws.service":   awsmiddleware.GetServiceID(ctx),
+			"aws.operation": operation,
+			"delay":         delay.String(),
+			"error":         err.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return out, metadata, err
+		case <-timer.C:
+		}
+
+		delay = min(2*delay, m.maxDelay) //nolint:mnd // exponential backoff
+	}
+}
+
+// isIAMPropagationRetryable returns whether the error returned by the specified operation
+// may be caused by IAM eventual consistency.
+func isIAMPropagationRetryable(operation string, err error) bool {
+	for _, prefix := range readOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range iamPropagationErrorCodes {
+		if apiErr.ErrorCode() == code {
+			return true
+		}
+	}
+
+	message := strings.ToLower(apiErr.ErrorMessage())
+	for _, fragment := range iamPropagationErrorMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestIsIAMPropagationRetryable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		operation string
+		err       error
+		expected  bool
+	}{
+		{
+			name:      "non-API error",
+			operation: "CreateFunction",
+			err:       errors.New("testing"),
+		},
+		{
+			name:      "unrelated API error",
+			operation: "CreateFunction",
+			err:       &smithy.GenericAPIError{Code: "ResourceConflictException", Message: "Function already exist"},
+		},
+		{
+			name:      "access denied",
+			operation: "CreateFunction",
+			err:       &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"},
+		},
+		{
+			name:      "invalid role",
+			operation: "CreateDeliveryStream",
+			err:       &smithy.GenericAPIError{Code: "InvalidRoleException", Message: "role is not valid"},
+			expected:  true,
+		},
+		{
+			name:      "role cannot be assumed",
+			operation: "CreateFunction",
+			err:       &smithy.GenericAPIError{Code: "InvalidParameterValueException", Message: "The role defined for the function cannot be assumed by Lambda."},
+			expected:  true,
+		},
+		{
+			name:      "invalid principal",
+			operation: "PutKeyPolicy",
+			err:       &smithy.GenericAPIError{Code: "MalformedPolicyDocumentException", Message: "Policy contains a statement with one or more Invalid principals."},
+			expected:  true,
+		},
+		{
+			name:      "read operation",
+			operation: "GetBucketPolicy",
+			err:       &smithy.GenericAPIError{Code: "AccessDenied", Message: "Access Denied"},
+		},
+		{
+			name:      "list operation",
+			operation: "ListTagsForResource",
+			err:       &smithy.GenericAPIError{Code: "InvalidPrincipal", Message: "invalid principal"},
+		},
+		{
+			name:      "search operation",
+			operation: "SearchResources",
+			err:       &smithy.GenericAPIError{Code: "InvalidPrincipal", Message: "invalid principal"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isIAMPropagationRetryable(testCase.operation, testCase.err), testCase.expected; got != want {
+				t.Errorf("isIAMPropagationRetryable = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPropagationRetryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	errRoleNotAssumable := &smithy.GenericAPIError{Code: "InvalidParameterValueException", Message: "The role defined for the function cannot be assumed by Lambda."}
+	errOther := &smithy.GenericAPIError{Code: "ValidationException", Message: "invalid"}
+
+	testCases := []struct {
+		name          string
+		errs          []error
+		timeout       time.Duration
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "success",
+			errs:          []error{nil},
+			timeout:       time.Second,
+			expectedCalls: 1,
+		},
+		{
+			name:          "non-retryable",
+			errs:          []error{errOther},
+			timeout:       time.Second,
+			expectedCalls: 1,
+			expectedErr:   errOther,
+		},
+		{
+			name:          "eventually succeeds",
+			errs:          []error{errRoleNotAssumable, errRoleNotAssumable, nil},
+			timeout:       time.Second,
+			expectedCalls: 3,
+		},
+		{
+			name:          "times out",
+			errs:          []error{errRoleNotAssumable, errRoleNotAssumable, errRoleNotAssumable, errRoleNotAssumable},
+			timeout:       15 * time.Millisecond,
+			expectedCalls: 2,
+			expectedErr:   errRoleNotAssumable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &iamPropagationRetryMiddleware{
+				timeout:  testCase.timeout,
+				minDelay: 10 * time.Millisecond,
+				maxDelay: 10 * time.Millisecond,
+			}
+
+			var calls int
+			next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				err := testCase.errs[calls]
+				calls++
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			})
+
+			_, _, err := m.HandleInitialize(context.Background(), middleware.InitializeInput{}, next)
+
+			if got, want := calls, testCase.expectedCalls; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("err = %v, want %v", err, testCase.expectedErr)
+			}
+		})
+	}
+}
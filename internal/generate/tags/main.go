@@ -40,6 +40,7 @@ var (
 	untagInNeedTagType       = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags               = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
 	updateTagsNoIgnoreSystem = flag.Bool("UpdateTagsNoIgnoreSystem", false, "whether to not ignore system tags in UpdateTags")
+	updateTagsNoWait         = flag.Bool("UpdateTagsNoWait", false, "whether to not call WaitTagsPropagated from UpdateTags")
 	waitForPropagation       = flag.Bool("Wait", false, "whether to generate WaitTagsPropagated")
 
 	createTagsFunc             = flag.String("CreateTagsFunc", "createTags", "createTagsFunc")
@@ -87,6 +88,7 @@ var (
 	waitMinTimeout             = flag.Duration("WaitMinTimeout", 0, `"MinTimeout" (minimum poll interval) for Wait function`)
 	waitPollInterval           = flag.Duration("WaitPollInterval", 0, "PollInterval for Wait function")
 	waitTimeout                = flag.Duration("WaitTimeout", 0, "Timeout for Wait function")
+	waitExactMatch             = flag.Bool("WaitExactMatch", false, "whether Wait function requires observed tags to equal desired tags")
 
 	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
 	parentNotFoundErrMsg  = flag.String("ParentNotFoundErrMsg", "", "Parent 'NotFound' Error Message")
@@ -209,6 +211,7 @@ type TemplateData struct {
 	UntagOp                    string
 	UpdateTagsFunc             string
 	UpdateTagsIgnoreSystem     bool
+	UpdateTagsWait             bool
 	WaitForPropagation         bool
 	WaitTagsPropagatedFunc     string
 	WaitContinuousOccurence    int
@@ -216,6 +219,7 @@ type TemplateData struct {
 	WaitMinTimeout             string
 	WaitPollInterval           string
 	WaitTimeout                string
+	WaitExactMatch             bool
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
@@ -324,9 +328,9 @@ func main() {
 		SkipServiceImp:    *skipServiceImp,
 		SkipTypesImp:      *skipTypesImp,
 		TfLogPkg:          *updateTags,
-		TfResourcePkg:     *getTag || *waitForPropagation || *retryTagsListTagsType != "",
+		TfResourcePkg:     *getTag || *retryTagsListTagsType != "",
 		TfSlicesPkg:       *serviceTagsSlice && *tagTypeIDElem != "" && *tagTypeAddBoolElem != "",
-		TimePkg:           *waitForPropagation || *retryTagsListTagsType != "",
+		TimePkg:           (*waitForPropagation && (*waitDelay != 0 || *waitMinTimeout != 0 || *waitPollInterval != 0 || *waitTimeout != 0)) || *retryTagsListTagsType != "",
 
 		CreateTagsFunc:             createTagsFunc,
 		GetTagFunc:                 *getTagFunc,
@@ -374,6 +378,7 @@ func main() {
 		UntagOp:                    *untagOp,
 		UpdateTagsFunc:             *updateTagsFunc,
 		UpdateTagsIgnoreSystem:     !*updateTagsNoIgnoreSystem,
+		UpdateTagsWait:             *waitForPropagation && !*updateTagsNoWait,
 		WaitForPropagation:         *waitForPropagation,
 		WaitTagsPropagatedFunc:     *waitTagsPropagatedFunc,
 		WaitContinuousOccurence:    *waitContinuousOccurence,
@@ -381,6 +386,7 @@ func main() {
 		WaitMinTimeout:             formatDuration(*waitMinTimeout),
 		WaitPollInterval:           formatDuration(*waitPollInterval),
 		WaitTimeout:                formatDuration(*waitTimeout),
+		WaitExactMatch:             *waitExactMatch,
 
 		IsDefaultListTags:   *listTagsFunc == defaultListTagsFunc,
 		IsDefaultUpdateTags: *updateTagsFunc == defaultUpdateTagsFunc,
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+// PolicyStringsEquivalent returns whether two JSON strings representing IAM (or IAM-like resource) policies
+// are semantically equivalent.
+// Statement, key and value ordering, duplicate values, single-element arrays versus scalars,
+// principal formats (e.g. "*" versus {"AWS": "*"} and account IDs versus root ARNs), the case of
+// actions and condition keys, and condition value types (e.g. true versus "true") are all ignored.
+// Empty strings and empty JSON objects ("{}") are considered equivalent.
+// This is the single policy comparison used by all policy attributes in the provider.
+func PolicyStringsEquivalent(s1, s2 string) bool {
+	if s1, s2 := strings.TrimSpace(s1), strings.TrimSpace(s2); (s1 == "" || s1 == "{}") && (s2 == "" || s2 == "{}") {
+		return true
+	}
+
+	equivalent, err := PoliciesAreEquivalent(s1, s2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// PoliciesAreEquivalent is a drop-in replacement for awspolicyequivalence.PoliciesAreEquivalent
+// that first normalizes both policies with NormalizePolicy.
+// An error is returned if either policy is not a valid policy document.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	return awspolicy.PoliciesAreEquivalent(NormalizePolicy(policy1), NormalizePolicy(policy2))
+}
+
+// NormalizePolicy returns the canonical form of a JSON policy document:
+//   - statements are always an array, ordered by their canonical JSON
+//   - the anonymous principal ("Principal": "*") is rewritten to the form returned by many services ("Principal": {"AWS": "*"})
+//   - actions, which IAM matches case-insensitively, are lower-cased
+//   - condition keys, which IAM matches case-insensitively, are lower-cased
+//   - string arrays in actions, resources and principals are sorted and de-duplicated, and single-element arrays become scalars
+//
+// The input is returned unchanged if it is not a JSON object.
+func NormalizePolicy(policy string) string {
+	var doc map[string]any
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return policy
+	}
+
+	var statements []any
+	switch v := doc["Statement"].(type) {
+	case []any:
+		statements = v
+	case map[string]any:
+		statements = []any{v}
+	default:
+		return policy
+	}
+
+	type keyedStatement struct {
+		key       string
+		statement any
+	}
+
+	keyed := make([]keyedStatement, 0, len(statements))
+	for _, statement := range statements {
+		if statement, ok := statement.(map[string]any); ok {
+			normalizePolicyStatement(statement)
+		}
+
+		b, err := json.Marshal(statement)
+		if err != nil {
+			return policy
+		}
+
+		keyed = append(keyed, keyedStatement{key: string(b), statement: statement})
+	}
+
+	slices.SortStableFunc(keyed, func(a, b keyedStatement) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	statements = make([]any, 0, len(keyed))
+	for _, v := range keyed {
+		statements = append(statements, v.statement)
+	}
+	doc["Statement"] = statements
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return policy
+	}
+
+	return string(b)
+}
+
+func normalizePolicyStatement(statement map[string]any) {
+	for _, key := range []string{"Principal", "NotPrincipal"} {
+		switch v := statement[key].(type) {
+		case string:
+			if v == "*" {
+				statement[key] = map[string]any{"AWS": "*"}
+			}
+		case map[string]any:
+			for k, values := range v {
+				v[k] = normalizePolicyStrings(values, false)
+			}
+		}
+	}
+
+	for _, key := range []string{"Action", "NotAction"} {
+		if v, ok := statement[key]; ok {
+			statement[key] = normalizePolicyStrings(v, true)
+		}
+	}
+
+	for _, key := range []string{"Resource", "NotResource"} {
+		if v, ok := statement[key]; ok {
+			statement[key] = normalizePolicyStrings(v, false)
+		}
+	}
+
+	if v, ok := statement["Condition"].(map[string]any); ok {
+		for operator, conditions := range v {
+			conditions, ok := conditions.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			normalized := make(map[string]any, len(conditions))
+			for k, values := range conditions {
+				k = strings.ToLower(k)
+				if _, ok := normalized[k]; ok {
+					// Keys differing only in case are left for the policy service to reject.
+					normalized = conditions
+					break
+				}
+				normalized[k] = values
+			}
+			v[operator] = normalized
+		}
+	}
+}
+
+// normalizePolicyStrings sorts and de-duplicates a string or array of strings, optionally lower-casing each value.
+// A single value is returned as a scalar. Values that are not strings are returned unchanged.
+func normalizePolicyStrings(v any, lower bool) any {
+	var values []string
+	switch v := v.(type) {
+	case string:
+		values = []string{v}
+	case []any:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return v
+			}
+			values = append(values, s)
+		}
+	default:
+		return v
+	}
+
+	if lower {
+		for i, s := range values {
+			values[i] = strings.ToLower(s)
+		}
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	result := make([]any, len(values))
+	for i, s := range values {
+		result[i] = s
+	}
+
+	return result
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+)
+
+func TestPolicyStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		policy1  string
+		policy2  string
+		want     bool
+	}{
+		{
+			testName: "empty strings",
+			policy1:  "",
+			policy2:  " ",
+			want:     true,
+		},
+		{
+			testName: "empty string and empty JSON",
+			policy1:  "",
+			policy2:  "{}",
+			want:     true,
+		},
+		{
+			testName: "empty JSON and policy",
+			policy1:  "{}",
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want:     false,
+		},
+		{
+			testName: "key ordering",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Statement":[{"Resource":"*","Action":"s3:GetObject","Effect":"Allow"}],"Version":"2012-10-17"}`,
+			want:     true,
+		},
+		{
+			testName: "single element arrays",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			want:     true,
+		},
+		{
+			testName: "principal formats",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			testName: "account ID and root ARN principals",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			testName: "condition value types",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["false"]},"NumericLessThan":{"s3:TlsVersion":"1.2"}}}]}`,
+			want:     true,
+		},
+		{
+			testName: "statement ordering",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			testName: "action ordering and duplicates",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			testName: "action case",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:getobject","Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			testName: "condition key case",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:securetransport":"false"}}}]}`,
+			want:     true,
+		},
+		{
+			testName: "resource case",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Bucket/*"}]}`, // lintignore:AWSAT005
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, // lintignore:AWSAT005
+			want:     false,
+		},
+		{
+			testName: "different actions",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			want:     false,
+		},
+		{
+			testName: "invalid JSON",
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17",`,
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := PolicyStringsEquivalent(testCase.policy1, testCase.policy2), testCase.want; got != want {
+				t.Errorf("PolicyStringsEquivalent(%q, %q) = %t, want %t", testCase.policy1, testCase.policy2, got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		policy   string
+		want     string
+	}{
+		{
+			testName: "invalid JSON",
+			policy:   `{"Version":"2012-10-17",`,
+			want:     `{"Version":"2012-10-17",`,
+		},
+		{
+			testName: "no statements",
+			policy:   `{"Version":"2012-10-17"}`,
+			want:     `{"Version":"2012-10-17"}`,
+		},
+		{
+			testName: "canonical form",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Principal":"*","Action":["S3:PutObject","s3:GetObject","s3:getobject"],"Resource":["*"]},{"Sid":"A","Effect":"Deny","Principal":{"AWS":["123456789012"]},"Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"S3:TlsVersion":1.2}}}]}`,
+			want:     `{"Statement":[{"Action":"s3:*","Condition":{"NumericLessThan":{"s3:tlsversion":1.2}},"Effect":"Deny","Principal":{"AWS":"123456789012"},"Resource":"*","Sid":"A"},{"Action":["s3:getobject","s3:putobject"],"Effect":"Allow","Principal":{"AWS":"*"},"Resource":"*","Sid":"B"}],"Version":"2012-10-17"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := NormalizePolicy(testCase.policy), testCase.want; got != want {
+				t.Errorf("NormalizePolicy(%q) = %q, want %q", testCase.policy, got, want)
+			}
+		})
+	}
+}
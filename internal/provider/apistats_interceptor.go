@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
+)
+
+// apiStatsInterceptor records the duration of each CRUD handler in the diagnostics summary.
+type apiStatsInterceptor struct{}
+
+func (apiStatsInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = apistats.StartOperation(ctx)
+	case Finally:
+		apistats.EndOperation(ctx, why.operation(), diags.HasError())
+	}
+
+	return ctx, diags
+}
+
+// operation returns the name of the CRUD operation as recorded in the diagnostics summary.
+func (w why) operation() string {
+	switch w {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
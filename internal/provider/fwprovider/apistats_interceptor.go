@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// recordAPIStats records the duration of a CRUD handler in the diagnostics summary.
+func recordAPIStats(ctx context.Context, operation string, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = apistats.StartOperation(ctx)
+	case Finally:
+		apistats.EndOperation(ctx, operation, diags.HasError())
+	}
+
+	return ctx, diags
+}
+
+// apiStatsInterceptor records the duration of each resource CRUD handler in the diagnostics summary.
+type apiStatsInterceptor struct{}
+
+func (apiStatsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return recordAPIStats(ctx, "create", when, diags)
+}
+
+func (apiStatsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return recordAPIStats(ctx, "read", when, diags)
+}
+
+func (apiStatsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return recordAPIStats(ctx, "update", when, diags)
+}
+
+func (apiStatsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return recordAPIStats(ctx, "delete", when, diags)
+}
+
+// apiStatsDataSourceInterceptor records the duration of each data source Read in the diagnostics summary.
+type apiStatsDataSourceInterceptor struct{}
+
+func (apiStatsDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return recordAPIStats(ctx, "read", when, diags)
+}
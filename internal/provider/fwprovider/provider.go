@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
@@ -68,6 +69,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether the provider targets AWS (`aws`) or non-AWS, API-compatible endpoints (`custom`). `custom` skips credentials, region and account ID validation, disables the EC2 metadata API check and uses S3 path-style addressing. Can also be configured using the `TF_AWS_ENDPOINT_URL_MODE` environment variable.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -80,6 +85,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_propagation_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum duration to retry create and update operations that fail because a recently created or modified IAM principal or policy has not yet propagated. Valid time units are ns, us (or µs), ms, s, h, or m. If omitted, such errors are not retried by default. Only applies to services using the AWS SDK for Go v2.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -88,6 +97,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"max_retries_per_service": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of times an AWS API request is being executed for specific services, keyed by service name as used in the `endpoints` block. Overrides `max_retries` for those services.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -167,9 +181,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM roles to assume, in order. Each role after the first is assumed using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -259,6 +271,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_secretsmanager_secret`, to which default tags are not applied.",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Services, as named in the `endpoints` block, to whose resources default tags are not applied.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -334,6 +356,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = apistats.NewContext(ctx, "data."+typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -343,6 +366,10 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			if apistats.Enabled() {
+				interceptors = append(interceptors, apiStatsDataSourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -405,8 +432,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = apistats.NewContext(ctx, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -414,6 +442,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			if apistats.Enabled() {
+				interceptors = append(interceptors, apiStatsInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -65,6 +67,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_secretsmanager_secret`, to which default tags are not applied.",
+						},
+						"exclude_services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Services, as named in the `endpoints` block, to whose resources default tags are not applied.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -86,6 +100,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{conns.EndpointURLModeAWS, conns.EndpointURLModeCustom}, false),
+				Description: "Whether the provider targets AWS (`aws`) or non-AWS, API-compatible endpoints (`custom`). " +
+					"`custom` skips credentials, region and account ID validation, disables the EC2 metadata API check and uses S3 path-style addressing. " +
+					"Can also be configured using the `TF_AWS_ENDPOINT_URL_MODE` environment variable.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -127,6 +149,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"iam_propagation_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validIAMPropagationTimeout,
+				Description: "The maximum duration to retry create and update operations that fail because a recently " +
+					"created or modified IAM principal or policy has not yet propagated. Valid time units are ns, us (or µs), ms, s, h, or m. " +
+					"If omitted, such errors are not retried by default. Only applies to services using the AWS SDK for Go v2.",
+			},
 			"insecure": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -140,6 +170,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"max_retries_per_service": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "The maximum number of times an AWS API request is being executed for specific services, " +
+					"keyed by service name as used in the `endpoints` block. Overrides `max_retries` for those services.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -289,6 +326,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = apistats.NewContext(ctx, "data."+typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -298,6 +336,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if apistats.Enabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: apiStatsInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -366,8 +412,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = apistats.NewContext(ctx, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -375,6 +422,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if apistats.Enabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: apiStatsInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -506,6 +561,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.Get("endpoint_url_mode").(string); ok && v != "" {
+		config.EndpointURLMode = v
+	} else if conns.IsCustomEndpointURLModeFromEnv() {
+		config.EndpointURLMode = conns.EndpointURLModeCustom
+	}
+
+	if v, ok := d.Get("iam_propagation_timeout").(string); ok && v != "" {
+		timeout, _ := time.ParseDuration(v)
+		config.IAMPropagationTimeout = timeout
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
@@ -514,14 +580,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -571,6 +646,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("max_retries_per_service"); ok && len(v.(map[string]interface{})) > 0 {
+		maxRetriesPerService, err := expandMaxRetriesPerService(v.(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.MaxRetriesPerService = maxRetriesPerService
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -587,6 +670,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if config.EndpointURLMode == conns.EndpointURLModeCustom {
+		// Non-AWS endpoints generally have no STS, IAM or EC2 metadata API and use path-style S3 addressing.
+		config.SkipCredsValidation = true
+		config.SkipRegionValidation = true
+		config.SkipRequestingAccountId = true
+		config.S3UsePathStyle = true
+		if config.EC2MetadataServiceEnableState == imds.ClientDefaultEnableState {
+			config.EC2MetadataServiceEnableState = imds.ClientDisabled
+		}
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -605,9 +699,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM roles to assume, in order. Each role after the first is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -849,6 +943,20 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			// Accept service aliases as in the endpoints block.
+			if pkg, err := names.ProviderPackageForAlias(v); err == nil {
+				v = pkg
+			}
+			defaultConfig.ExcludeServices = append(defaultConfig.ExcludeServices, v)
+		}
+	}
+
 	return defaultConfig
 }
 
@@ -870,6 +978,29 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandMaxRetriesPerService(tfMap map[string]interface{}) (map[string]int, error) {
+	servicePackages := names.ProviderPackages()
+	maxRetriesPerService := make(map[string]int, len(tfMap))
+
+	for k, v := range tfMap {
+		pkg := k
+		if !slices.Contains(servicePackages, pkg) {
+			var err error
+			if pkg, err = names.ProviderPackageForAlias(k); err != nil {
+				return nil, fmt.Errorf("max_retries_per_service: unsupported service %q", k)
+			}
+		}
+
+		if v := v.(int); v < 1 {
+			return nil, fmt.Errorf("max_retries_per_service: value for %q must be at least 1, got %d", k, v)
+		}
+
+		maxRetriesPerService[pkg] = v.(int)
+	}
+
+	return maxRetriesPerService, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
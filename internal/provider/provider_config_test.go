@@ -7,6 +7,7 @@ import (
 	"context"
 	"maps"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -205,6 +206,84 @@ sso_start_url = https://d-123456789a.awsapps.com/start#
 	}
 }
 
+func TestProviderConfig_AssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	ctx := context.TODO()
+
+	servicemocks.InitSessionTestEnv(t)
+
+	const (
+		chainedRoleARN         = "arn:aws:iam::666666666666:role/ChainedRole" // lintignore:AWSAT005
+		chainedRoleSessionName = "ChainedRoleSessionName"
+		chainedRoleAccessKey   = "ChainedRoleAccessKey"
+	)
+
+	chainedRoleEndpoint := servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+		"RoleArn":         chainedRoleARN,
+		"RoleSessionName": chainedRoleSessionName,
+	})
+	chainedRoleEndpoint.Response.Body = strings.ReplaceAll(chainedRoleEndpoint.Response.Body, servicemocks.MockStsAssumeRoleAccessKey, chainedRoleAccessKey)
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleValidEndpoint,
+		chainedRoleEndpoint,
+	})
+	t.Cleanup(func() {
+		ts.Close()
+	})
+
+	config := map[string]any{
+		"access_key": servicemocks.MockStaticAccessKey,
+		"assume_role": []any{
+			map[string]any{
+				"role_arn":     servicemocks.MockStsAssumeRoleArn,
+				"session_name": servicemocks.MockStsAssumeRoleSessionName,
+			},
+			map[string]any{
+				"role_arn":     chainedRoleARN,
+				"session_name": chainedRoleSessionName,
+			},
+		},
+		"endpoints": []any{
+			map[string]any{
+				"sts": ts.URL,
+			},
+		},
+		"region":                      "us-west-2", // lintignore:AWSAT003
+		"secret_key":                  servicemocks.MockStaticSecretKey,
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+	}
+
+	rc := terraformsdk.NewResourceConfigRaw(config)
+
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	diags = append(diags, p.Validate(rc)...)
+	if diags.HasError() {
+		t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+	}
+
+	diags = append(diags, p.Configure(ctx, rc)...)
+	if diags.HasError() {
+		t.Fatalf("configuring: %s", sdkdiag.DiagnosticsString(diags))
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	credentials, err := meta.CredentialsProvider(ctx).Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("retrieving credentials: %s", err)
+	}
+
+	if a, e := credentials.AccessKeyID, chainedRoleAccessKey; a != e {
+		t.Errorf("expected access key %q, got %q", e, a)
+	}
+}
+
 type DiagsValidator func(*testing.T, diag.Diagnostics)
 
 var _ configtesting.TestDriver = &testDriver{}
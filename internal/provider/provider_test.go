@@ -59,6 +59,58 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandMaxRetriesPerService(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap         map[string]interface{}
+		expected      map[string]int
+		expectedError bool
+	}{
+		"service package names": {
+			tfMap:    map[string]interface{}{"ec2": 50, "s3": 10},
+			expected: map[string]int{"ec2": 50, "s3": 10},
+		},
+		"alias": {
+			tfMap:    map[string]interface{}{"cloudwatchlogs": 30},
+			expected: map[string]int{"logs": 30},
+		},
+		"unknown service": {
+			tfMap:         map[string]interface{}{"notaservice": 30},
+			expectedError: true,
+		},
+		"invalid value": {
+			tfMap:         map[string]interface{}{"ec2": 0},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandMaxRetriesPerService(testCase.tfMap)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -73,3 +73,50 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidIAMPropagationTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "-1m",
+			expectedErr: regexache.MustCompile(`must be between 0 and 30 minutes \(30m\)`),
+		},
+		{
+			val:         "31m",
+			expectedErr: regexache.MustCompile(`must be between 0 and 30 minutes \(30m\)`),
+		},
+		{
+			val: "0s",
+		},
+		{
+			val: "2m",
+		},
+		{
+			val: "30m",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validIAMPropagationTimeout(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
@@ -5,15 +5,19 @@ package apigatewayv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,10 +27,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_apigatewayv2_api", name="API")
@@ -123,10 +129,20 @@ func resourceAPI() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"drifted_routes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"execution_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fail_on_unmanaged_routes": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"openapi_managed"},
+			},
 			"fail_on_warnings": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -136,6 +152,11 @@ func resourceAPI() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
+			"openapi_managed": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"body"},
+			},
 			"protocol_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -159,6 +180,11 @@ func resourceAPI() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"unmanaged_routes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrVersion: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -166,7 +192,10 @@ func resourceAPI() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			openAPIRouteDriftCustomizeDiff,
+		),
 	}
 }
 
@@ -264,6 +293,22 @@ func resourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("route_selection_expression", output.RouteSelectionExpression)
 	d.Set(names.AttrVersion, output.Version)
 
+	// When the OpenAPI definition is managed by Terraform, record any routes or integrations changed outside of Terraform.
+	var driftedRoutes, unmanagedRoutes []string
+	if body := d.Get("body").(string); body != "" && d.Get("openapi_managed").(bool) {
+		driftedRoutes, unmanagedRoutes, err = findAPIRouteDrift(ctx, conn, d.Id(), body)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if len(driftedRoutes) > 0 {
+			log.Printf("[WARN] API Gateway v2 API (%s) routes changed outside of Terraform: %s", d.Id(), strings.Join(driftedRoutes, ", "))
+		}
+	}
+	d.Set("drifted_routes", driftedRoutes)
+	d.Set("unmanaged_routes", unmanagedRoutes)
+
 	setTagsOut(ctx, output.Tags)
 
 	return diags
@@ -329,7 +374,8 @@ func resourceAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("body") {
+	// Reimporting the OpenAPI definition also reverts routes changed outside of Terraform.
+	if d.HasChanges("body", "drifted_routes") {
 		err := reimportOpenAPIDefinition(ctx, d, meta)
 
 		if err != nil {
@@ -449,6 +495,180 @@ func findAPI(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2
 	return output, nil
 }
 
+func findAPIExportByID(ctx context.Context, conn *apigatewayv2.Client, id string) (string, error) {
+	input := &apigatewayv2.ExportApiInput{
+		ApiId:             aws.String(id),
+		IncludeExtensions: aws.Bool(true),
+		OutputType:        aws.String("JSON"),
+		Specification:     aws.String("OAS30"),
+	}
+
+	output, err := conn.ExportApi(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || len(output.Body) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return string(output.Body), nil
+}
+
+// findAPIRouteDrift compares the API's exported OpenAPI definition with the specified definition.
+// It returns the keys of routes that differ, and the subset of those that are not defined in body.
+func findAPIRouteDrift(ctx context.Context, conn *apigatewayv2.Client, id, body string) ([]string, []string, error) {
+	export, err := findAPIExportByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading API Gateway v2 API (%s) OpenAPI definition: %w", id, err)
+	}
+
+	want, err := openAPIRouteIntegrationTypes(body)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing API Gateway v2 API (%s) OpenAPI definition: %w", id, err)
+	}
+
+	got, err := openAPIRouteIntegrationTypes(export)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing API Gateway v2 API (%s) exported OpenAPI definition: %w", id, err)
+	}
+
+	driftedRoutes := openAPIRouteDifferences(want, got)
+	unmanagedRoutes := tfslices.Filter(driftedRoutes, func(routeKey string) bool {
+		_, ok := want[routeKey]
+		return !ok
+	})
+
+	return driftedRoutes, unmanagedRoutes, nil
+}
+
+// openAPIRouteDriftCustomizeDiff plans a reimport of the OpenAPI definition when routes were changed outside of Terraform.
+// It uses the route drift recorded by the last refresh, so no API calls are made at plan time.
+func openAPIRouteDriftCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("openapi_managed").(bool) {
+		return nil
+	}
+
+	if d.Get("fail_on_unmanaged_routes").(bool) && d.NewValueKnown("body") {
+		if unmanagedRoutes := d.Get("unmanaged_routes").(*schema.Set); unmanagedRoutes.Len() > 0 {
+			want, err := openAPIRouteIntegrationTypes(d.Get("body").(string))
+
+			if err != nil {
+				return fmt.Errorf("parsing API Gateway v2 API (%s) OpenAPI definition: %w", d.Id(), err)
+			}
+
+			var routeKeys []string
+			for _, routeKey := range flex.ExpandStringValueSet(unmanagedRoutes) {
+				if _, ok := want[routeKey]; !ok {
+					routeKeys = append(routeKeys, routeKey)
+				}
+			}
+
+			if len(routeKeys) > 0 {
+				slices.Sort(routeKeys)
+				return fmt.Errorf("API Gateway v2 API (%s) has routes that are not defined in body: %s", d.Id(), strings.Join(routeKeys, ", "))
+			}
+		}
+	}
+
+	if d.Get("drifted_routes").(*schema.Set).Len() > 0 {
+		if err := d.SetNew("drifted_routes", []string{}); err != nil {
+			return err
+		}
+
+		return d.SetNew("unmanaged_routes", []string{})
+	}
+
+	return nil
+}
+
+// openAPIRouteIntegrationTypes returns a normalized view of the routes defined in an OpenAPI definition,
+// a map of route key (e.g. "GET /pets") to the (upper-cased) type of the route's integration, if any.
+func openAPIRouteIntegrationTypes(body string) (map[string]string, error) {
+	var definition struct {
+		Paths map[string]map[string]interface{} `json:"paths" yaml:"paths"`
+	}
+
+	if err := json.Unmarshal([]byte(body), &definition); err != nil {
+		if err := yaml.Unmarshal([]byte(body), &definition); err != nil {
+			return nil, err
+		}
+	}
+
+	routes := make(map[string]string)
+	for path, pathItem := range definition.Paths {
+		for method, operation := range pathItem {
+			var routeKey string
+			switch method := strings.ToLower(method); method {
+			case "delete", "get", "head", "options", "patch", "post", "put":
+				routeKey = strings.ToUpper(method) + " " + path
+			case "x-amazon-apigateway-any-method":
+				if path == "/$default" {
+					routeKey = "$default"
+				} else {
+					routeKey = "ANY " + path
+				}
+			default:
+				continue
+			}
+
+			var integrationType string
+			if v, ok := openAPIObjectValue(openAPIObjectValue(operation, "x-amazon-apigateway-integration"), names.AttrType).(string); ok {
+				integrationType = strings.ToUpper(v)
+			}
+
+			routes[routeKey] = integrationType
+		}
+	}
+
+	return routes, nil
+}
+
+// openAPIObjectValue returns the value of the specified key in a decoded JSON or YAML object.
+func openAPIObjectValue(v interface{}, key string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v[key]
+	case map[interface{}]interface{}:
+		return v[key]
+	}
+
+	return nil
+}
+
+// openAPIRouteDifferences returns the sorted keys of routes that are missing, unexpected or whose integration type differs.
+// Integration types are only compared when specified in the desired routes.
+func openAPIRouteDifferences(want, got map[string]string) []string {
+	var routeKeys []string
+
+	for routeKey, wantType := range want {
+		if gotType, ok := got[routeKey]; !ok || (wantType != "" && wantType != gotType) {
+			routeKeys = append(routeKeys, routeKey)
+		}
+	}
+
+	for routeKey := range got {
+		if _, ok := want[routeKey]; !ok {
+			routeKeys = append(routeKeys, routeKey)
+		}
+	}
+
+	slices.Sort(routeKeys)
+
+	return routeKeys
+}
+
 func expandCORSConfiguration(vConfiguration []interface{}) *awstypes.Cors {
 	configuration := &awstypes.Cors{}
 
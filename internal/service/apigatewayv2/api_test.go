@@ -554,6 +554,56 @@ func TestAccAPIGatewayV2API_OpenAPI_failOnWarnings(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2API_OpenAPI_managed(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigatewayv2.GetApiOutput
+	resourceName := "aws_apigatewayv2_api.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_openAPIManaged(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_unmanaged_routes", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "openapi_managed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "drifted_routes.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_routes.#", acctest.Ct0),
+					testAccCheckAPIRoutes(ctx, &v, []string{"GET /test"}),
+				),
+			},
+			// A route created outside of the OpenAPI definition is reported as drift and planned to be removed by reimporting body.
+			{
+				Config: testAccAPIConfig_openAPIManagedUnmanagedRoute(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					testAccCheckAPIRoutes(ctx, &v, []string{"GET /test", "GET /unmanaged"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drifted_routes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "drifted_routes.*", "GET /unmanaged"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_routes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "unmanaged_routes.*", "GET /unmanaged"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccAPIConfig_openAPIManagedUnmanagedRoute(rName, true),
+				ExpectError: regexache.MustCompile(`has routes that are not defined in body: GET /unmanaged`),
+			},
+		},
+	})
+}
+
 func testAccCheckAPIRoutes(ctx context.Context, v *apigatewayv2.GetApiOutput, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Client(ctx)
@@ -1276,3 +1326,44 @@ EOF
 }
 `, rName, failOnWarnings)
 }
+
+func testAccAPIConfig_openAPIManaged(rName string, failOnUnmanagedRoutes bool) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name                     = %[1]q
+  protocol_type            = "HTTP"
+  openapi_managed          = true
+  fail_on_unmanaged_routes = %[2]t
+  body                     = <<EOF
+{
+  "openapi": "3.0.1",
+  "info": {
+    "title": %[1]q,
+    "version": "1.0"
+  },
+  "paths": {
+    "/test": {
+      "get": {
+        "x-amazon-apigateway-integration": {
+          "type": "HTTP_PROXY",
+          "httpMethod": "GET",
+          "payloadFormatVersion": "1.0",
+          "uri": "https://www.google.de"
+        }
+      }
+    }
+  }
+}
+EOF
+}
+`, rName, failOnUnmanagedRoutes)
+}
+
+func testAccAPIConfig_openAPIManagedUnmanagedRoute(rName string, failOnUnmanagedRoutes bool) string {
+	return acctest.ConfigCompose(testAccAPIConfig_openAPIManaged(rName, failOnUnmanagedRoutes), `
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /unmanaged"
+}
+`)
+}
@@ -238,8 +238,9 @@ func resourceConnectorProfile() *schema.Resource {
 																},
 															},
 															"refresh_token": {
-																Type:     schema.TypeString,
-																Optional: true,
+																Type:      schema.TypeString,
+																Optional:  true,
+																Sensitive: true,
 																ValidateFunc: validation.All(
 																	validation.StringLenBetween(1, 4096),
 																	validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
@@ -351,8 +352,9 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 												},
 												"refresh_token": {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:      schema.TypeString,
+													Optional:  true,
+													Sensitive: true,
 													ValidateFunc: validation.All(
 														validation.StringLenBetween(1, 1024),
 														validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
@@ -402,8 +404,9 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 												},
 												"refresh_token": {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:      schema.TypeString,
+													Optional:  true,
+													Sensitive: true,
 													ValidateFunc: validation.All(
 														validation.StringLenBetween(1, 1024),
 														validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
@@ -560,6 +563,7 @@ func resourceConnectorProfile() *schema.Resource {
 												"jwt_token": {
 													Type:         schema.TypeString,
 													Optional:     true,
+													Sensitive:    true,
 													ValidateFunc: validation.StringLenBetween(1, 8000),
 												},
 												"oauth2_grant_type": {
@@ -593,8 +597,9 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 												},
 												"refresh_token": {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:      schema.TypeString,
+													Optional:  true,
+													Sensitive: true,
 													ValidateFunc: validation.All(
 														validation.StringLenBetween(1, 1024),
 														validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
@@ -689,8 +694,9 @@ func resourceConnectorProfile() *schema.Resource {
 																},
 															},
 															"refresh_token": {
-																Type:     schema.TypeString,
-																Optional: true,
+																Type:      schema.TypeString,
+																Optional:  true,
+																Sensitive: true,
 																ValidateFunc: validation.All(
 																	validation.StringLenBetween(1, 1024),
 																	validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
@@ -425,6 +425,12 @@ func resourceFlow() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"data_transfer_api": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[types.SalesforceDataTransferApi](),
+												},
 												"error_handling_config": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -718,6 +724,39 @@ func resourceFlow() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`arn:.*:kms:.*:[0-9]+:.*`), "must be a valid ARN of a Key Management Services (KMS) key"),
 			},
+			"metadata_catalog_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_data_catalog": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDatabaseName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_prefix": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -917,6 +956,12 @@ func resourceFlow() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"data_transfer_api": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[types.SalesforceDataTransferApi](),
+												},
 												"enable_dynamic_field_update": {
 													Type:     schema.TypeBool,
 													Optional: true,
@@ -1288,6 +1333,10 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.KmsArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	output, err := conn.CreateFlow(ctx, input)
 
 	if err != nil {
@@ -1329,6 +1378,14 @@ func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	d.Set("flow_status", output.FlowStatus)
 	d.Set("kms_arn", output.KmsArn)
+	// A removed configuration may be returned as an empty object.
+	if output.MetadataCatalogConfig != nil && output.MetadataCatalogConfig.GlueDataCatalog != nil {
+		if err := d.Set("metadata_catalog_config", []interface{}{flattenMetadataCatalogConfig(output.MetadataCatalogConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metadata_catalog_config: %s", err)
+		}
+	} else {
+		d.Set("metadata_catalog_config", nil)
+	}
 	d.Set(names.AttrName, output.FlowName)
 	if output.SourceFlowConfig != nil {
 		if err := d.Set("source_flow_config", []interface{}{flattenSourceFlowConfig(output.SourceFlowConfig)}); err != nil {
@@ -1372,6 +1429,13 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Description = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("metadata_catalog_config") {
+			// Omitting the configuration leaves it unchanged, so an empty configuration is sent to remove it.
+			input.MetadataCatalogConfig = &types.MetadataCatalogConfig{}
+		}
+
 		_, err := conn.UpdateFlow(ctx, input)
 
 		if err != nil {
@@ -1834,6 +1898,10 @@ func expandSalesforceDestinationProperties(tfMap map[string]interface{}) *types.
 
 	a := &types.SalesforceDestinationProperties{}
 
+	if v, ok := tfMap["data_transfer_api"].(string); ok && v != "" {
+		a.DataTransferApi = types.SalesforceDataTransferApi(v)
+	}
+
 	if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		a.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
 	}
@@ -2260,6 +2328,10 @@ func expandSalesforceSourceProperties(tfMap map[string]interface{}) *types.Sales
 
 	a := &types.SalesforceSourceProperties{}
 
+	if v, ok := tfMap["data_transfer_api"].(string); ok && v != "" {
+		a.DataTransferApi = types.SalesforceDataTransferApi(v)
+	}
+
 	if v, ok := tfMap["enable_dynamic_field_update"].(bool); ok {
 		a.EnableDynamicFieldUpdate = v
 	}
@@ -2524,6 +2596,42 @@ func expandConnectorOperator(tfMap map[string]interface{}) *types.ConnectorOpera
 	return a
 }
 
+func expandMetadataCatalogConfig(tfMap map[string]interface{}) *types.MetadataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.MetadataCatalogConfig{}
+
+	if v, ok := tfMap["glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.GlueDataCatalog = expandGlueDataCatalogConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandGlueDataCatalogConfig(tfMap map[string]interface{}) *types.GlueDataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.GlueDataCatalogConfig{}
+
+	if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+		a.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		a.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_prefix"].(string); ok && v != "" {
+		a.TablePrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandTriggerConfig(tfMap map[string]interface{}) *types.TriggerConfig {
 	if tfMap == nil {
 		return nil
@@ -2923,6 +3031,8 @@ func flattenSalesforceDestinationProperties(salesforceDestinationProperties *typ
 
 	m := map[string]interface{}{}
 
+	m["data_transfer_api"] = salesforceDestinationProperties.DataTransferApi
+
 	if v := salesforceDestinationProperties.ErrorHandlingConfig; v != nil {
 		m["error_handling_config"] = []interface{}{flattenErrorHandlingConfig(v)}
 	}
@@ -3337,6 +3447,7 @@ func flattenSalesforceSourceProperties(salesforceSourceProperties *types.Salesfo
 
 	m := map[string]interface{}{}
 
+	m["data_transfer_api"] = salesforceSourceProperties.DataTransferApi
 	m["enable_dynamic_field_update"] = salesforceSourceProperties.EnableDynamicFieldUpdate
 	m["include_deleted_records"] = salesforceSourceProperties.IncludeDeletedRecords
 
@@ -3531,6 +3642,34 @@ func flattenConnectorOperator(connectorOperator *types.ConnectorOperator) map[st
 	return m
 }
 
+func flattenMetadataCatalogConfig(metadataCatalogConfig *types.MetadataCatalogConfig) map[string]interface{} {
+	if metadataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := metadataCatalogConfig.GlueDataCatalog; v != nil {
+		m["glue_data_catalog"] = []interface{}{flattenGlueDataCatalogConfig(v)}
+	}
+
+	return m
+}
+
+func flattenGlueDataCatalogConfig(glueDataCatalogConfig *types.GlueDataCatalogConfig) map[string]interface{} {
+	if glueDataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		names.AttrDatabaseName: aws.ToString(glueDataCatalogConfig.DatabaseName),
+		names.AttrRoleARN:      aws.ToString(glueDataCatalogConfig.RoleArn),
+		"table_prefix":         aws.ToString(glueDataCatalogConfig.TablePrefix),
+	}
+
+	return m
+}
+
 func flattenTriggerConfig(triggerConfig *types.TriggerConfig) map[string]interface{} {
 	if triggerConfig == nil {
 		return nil
@@ -283,6 +283,40 @@ func TestAccAppFlowFlow_task_mapAll(t *testing.T) {
 	})
 }
 
+func TestAccAppFlowFlow_metadataCatalogConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flowOutput appflow.DescribeFlowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFlowServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_metadataCatalogConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flowOutput),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.database_name", "aws_glue_catalog_database.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.table_prefix", "test"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_metadataCatalogConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flowOutput),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppFlowFlow_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowOutput appflow.DescribeFlowOutput
@@ -761,6 +795,113 @@ resource "aws_appflow_flow" "test" {
 	)
 }
 
+func testAccFlowConfig_metadataCatalogConfig(rName string, enabled bool) string {
+	var metadataCatalogConfig string
+	if enabled {
+		metadataCatalogConfig = `
+  metadata_catalog_config {
+    glue_data_catalog {
+      database_name = aws_glue_catalog_database.test.name
+      role_arn      = aws_iam_role.test.arn
+      table_prefix  = "test"
+    }
+  }
+`
+	}
+
+	return acctest.ConfigCompose(
+		testAccFlowConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appflow.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:BatchCreatePartition",
+        "glue:CreatePartitionIndex",
+        "glue:CreateTable",
+        "glue:GetDatabase",
+        "glue:GetPartitions",
+        "glue:GetTable",
+        "glue:GetTableVersions",
+        "glue:UpdateTable",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.test_source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.test_destination.bucket
+
+        s3_output_format_config {
+          prefix_config {
+            prefix_type = "PATH"
+          }
+        }
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+%[2]s
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, metadataCatalogConfig),
+	)
+}
+
 func testAccCheckFlowExists(ctx context.Context, n string, v *appflow.DescribeFlowOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -70,6 +70,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"introspectionConfig":                                 testAccGraphQLAPI_introspectionConfig,
 			"queryDepthLimit":                                     testAccGraphQLAPI_queryDepthLimit,
 			"resolverCountLimit":                                  testAccGraphQLAPI_resolverCountLimit,
+			"environmentVariables":                                testAccGraphQLAPI_environmentVariables,
 		},
 		"Function": {
 			acctest.CtBasic:           testAccFunction_basic,
@@ -40,7 +40,7 @@ func ResourceGraphQLAPI() *schema.Resource {
 		DeleteWithoutTimeout: resourceGraphQLAPIDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGraphQLAPIImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +136,15 @@ func ResourceGraphQLAPI() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
 			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyMatch(regexache.MustCompile(`^[A-Za-z]\w{1,63}$`), "must begin with a letter and contain between 2 and 64 alphanumeric or underscore characters"),
+					validation.MapValueLenBetween(0, 512),
+				),
+			},
 			"introspection_config": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -357,6 +366,12 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if v, ok := d.GetOk("environment_variables"); ok && len(v.(map[string]interface{})) > 0 {
+		if err := updateEnvironmentVariables(ctx, conn, d.Id(), nil, v.(map[string]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphQLAPIRead(ctx, d, meta)...)
 }
 
@@ -381,6 +396,22 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	d.Set(names.AttrARN, api.Arn)
 	d.Set("authentication_type", api.AuthenticationType)
+	// Only environment variables managed by Terraform are tracked, so that variables set outside of Terraform are preserved.
+	if v := d.Get("environment_variables").(map[string]interface{}); len(v) > 0 {
+		environmentVariables, err := findEnvironmentVariablesByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AppSync GraphQL API (%s) environment variables: %s", d.Id(), err)
+		}
+
+		tfMap := make(map[string]interface{})
+		for k := range v {
+			if v, ok := environmentVariables[k]; ok {
+				tfMap[k] = aws.StringValue(v)
+			}
+		}
+		d.Set("environment_variables", tfMap)
+	}
 	if err := d.Set("lambda_authorizer_config", flattenGraphQLAPILambdaAuthorizerConfig(api.LambdaAuthorizerConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting lambda_authorizer_config: %s", err)
 	}
@@ -412,7 +443,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("environment_variables", names.AttrTags, names.AttrTagsAll) {
 		input := &appsync.UpdateGraphqlApiInput{
 			ApiId:              aws.String(d.Id()),
 			AuthenticationType: aws.String(d.Get("authentication_type").(string)),
@@ -470,6 +501,14 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("environment_variables") {
+		o, n := d.GetChange("environment_variables")
+
+		if err := updateEnvironmentVariables(ctx, conn, d.Id(), o.(map[string]interface{}), n.(map[string]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphQLAPIRead(ctx, d, meta)...)
 }
 
@@ -512,6 +551,58 @@ func putSchema(ctx context.Context, conn *appsync.AppSync, apiID, definition str
 	return nil
 }
 
+func resourceGraphQLAPIImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	// Read only refreshes the environment variables already in state, so seed state with all of the API's variables.
+	environmentVariables, err := findEnvironmentVariablesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AppSync GraphQL API (%s) environment variables: %w", d.Id(), err)
+	}
+
+	d.Set("environment_variables", aws.StringValueMap(environmentVariables))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateEnvironmentVariables merges the Terraform-managed environment variables into the API's current environment variables.
+// Variables removed from the configuration are deleted and all other variables are left untouched.
+func updateEnvironmentVariables(ctx context.Context, conn *appsync.AppSync, apiID string, oldVariables, newVariables map[string]interface{}) error {
+	environmentVariables, err := findEnvironmentVariablesByID(ctx, conn, apiID)
+
+	if err != nil {
+		return fmt.Errorf("reading AppSync GraphQL API (%s) environment variables: %w", apiID, err)
+	}
+
+	if environmentVariables == nil {
+		environmentVariables = make(map[string]*string)
+	}
+
+	for k := range oldVariables {
+		if _, ok := newVariables[k]; !ok {
+			delete(environmentVariables, k)
+		}
+	}
+
+	for k, v := range newVariables {
+		environmentVariables[k] = aws.String(v.(string))
+	}
+
+	input := &appsync.PutGraphqlApiEnvironmentVariablesInput{
+		ApiId:                aws.String(apiID),
+		EnvironmentVariables: environmentVariables,
+	}
+
+	_, err = conn.PutGraphqlApiEnvironmentVariablesWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("putting AppSync GraphQL API (%s) environment variables: %w", apiID, err)
+	}
+
+	return nil
+}
+
 func FindGraphQLAPIByID(ctx context.Context, conn *appsync.AppSync, id string) (*appsync.GraphqlApi, error) {
 	input := &appsync.GetGraphqlApiInput{
 		ApiId: aws.String(id),
@@ -840,3 +931,28 @@ func flattenGraphQLAPICognitoUserPoolConfig(userPoolConfig *appsync.CognitoUserP
 
 	return []interface{}{m}
 }
+
+func findEnvironmentVariablesByID(ctx context.Context, conn *appsync.AppSync, id string) (map[string]*string, error) {
+	input := &appsync.GetGraphqlApiEnvironmentVariablesInput{
+		ApiId: aws.String(id),
+	}
+
+	output, err := conn.GetGraphqlApiEnvironmentVariablesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentVariables, nil
+}
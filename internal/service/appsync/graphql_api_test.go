@@ -1296,6 +1296,53 @@ func testAccGraphQLAPI_resolverCountLimit(t *testing.T) {
 	})
 }
 
+func testAccGraphQLAPI_environmentVariables(t *testing.T) {
+	ctx := acctest.Context(t)
+	var api1 appsync.GraphqlApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphQLAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphQLAPIConfig_environmentVariables1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSchema},
+			},
+			{
+				Config: testAccGraphQLAPIConfig_environmentVariables2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGraphQLAPIConfig_environmentVariables1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGraphQLAPIDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
@@ -1825,3 +1872,30 @@ resource "aws_appsync_graphql_api" "test" {
 }
 `, rName, resolverCountLimit)
 }
+
+func testAccGraphQLAPIConfig_environmentVariables1(rName, key1, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+
+  environment_variables = {
+    %[2]s = %[3]q
+  }
+}
+`, rName, key1, value1)
+}
+
+func testAccGraphQLAPIConfig_environmentVariables2(rName, key1, value1, key2, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+
+  environment_variables = {
+    %[2]s = %[3]q
+    %[4]s = %[5]q
+  }
+}
+`, rName, key1, value1, key2, value2)
+}
@@ -6,10 +6,12 @@ package auditmanager
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,7 +29,11 @@ import (
 
 // @FrameworkResource
 func newResourceFrameworkShare(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceFrameworkShare{}, nil
+	r := &resourceFrameworkShare{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -36,6 +42,7 @@ const (
 
 type resourceFrameworkShare struct {
 	framework.ResourceWithConfigure
+	framework.WithTimeouts
 }
 
 func (r *resourceFrameworkShare) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -77,6 +84,11 @@ func (r *resourceFrameworkShare) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
@@ -113,8 +125,18 @@ func (r *resourceFrameworkShare) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	id := aws.ToString(out.AssessmentFrameworkShareRequest.Id)
+	share, err := waitFrameworkShareCreated(ctx, conn, id, awstypes.ShareRequestTypeSent, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionWaitingForCreation, ResNameFrameworkShare, id, nil),
+			err.Error(),
+		)
+		return
+	}
+
 	state := plan
-	state.refreshFromOutput(ctx, out.AssessmentFrameworkShareRequest)
+	state.refreshFromOutput(ctx, share)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -192,8 +214,12 @@ func (r *resourceFrameworkShare) ImportState(ctx context.Context, req resource.I
 }
 
 func FindFrameworkShareByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	return findFrameworkShareByTwoPartKey(ctx, conn, id, awstypes.ShareRequestTypeSent)
+}
+
+func findFrameworkShareByTwoPartKey(ctx context.Context, conn *auditmanager.Client, id string, requestType awstypes.ShareRequestType) (*awstypes.AssessmentFrameworkShareRequest, error) {
 	in := &auditmanager.ListAssessmentFrameworkShareRequestsInput{
-		RequestType: awstypes.ShareRequestTypeSent,
+		RequestType: requestType,
 	}
 	pages := auditmanager.NewListAssessmentFrameworkShareRequestsPaginator(conn, in)
 
@@ -215,6 +241,59 @@ func FindFrameworkShareByID(ctx context.Context, conn *auditmanager.Client, id s
 	}
 }
 
+func statusFrameworkShare(ctx context.Context, conn *auditmanager.Client, id string, requestType awstypes.ShareRequestType) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFrameworkShareByTwoPartKey(ctx, conn, id, requestType)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitFrameworkShareCreated waits for a share request to finish replicating to the destination.
+// The request is then either waiting for the recipient (SHARED) or has already been accepted (ACTIVE).
+func waitFrameworkShareCreated(ctx context.Context, conn *auditmanager.Client, id string, requestType awstypes.ShareRequestType, timeout time.Duration) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ShareRequestStatusReplicating),
+		Target:  enum.Slice(awstypes.ShareRequestStatusShared, awstypes.ShareRequestStatusActive),
+		Refresh: statusFrameworkShare(ctx, conn, id, requestType),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AssessmentFrameworkShareRequest); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitFrameworkShareAccepted waits for an accepted share request to become active.
+func waitFrameworkShareAccepted(ctx context.Context, conn *auditmanager.Client, id string, timeout time.Duration) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ShareRequestStatusReplicating, awstypes.ShareRequestStatusShared),
+		Target:  enum.Slice(awstypes.ShareRequestStatusActive),
+		Refresh: statusFrameworkShare(ctx, conn, id, awstypes.ShareRequestTypeReceived),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AssessmentFrameworkShareRequest); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // CanBeRevoked verifies a framework share is in a status which can be revoked
 func CanBeRevoked(status string) bool {
 	nonRevokable := enum.Slice(
@@ -232,12 +311,13 @@ func CanBeRevoked(status string) bool {
 }
 
 type resourceFrameworkShareData struct {
-	Comment            types.String `tfsdk:"comment"`
-	DestinationAccount types.String `tfsdk:"destination_account"`
-	DestinationRegion  types.String `tfsdk:"destination_region"`
-	FrameworkID        types.String `tfsdk:"framework_id"`
-	ID                 types.String `tfsdk:"id"`
-	Status             types.String `tfsdk:"status"`
+	Comment            types.String   `tfsdk:"comment"`
+	DestinationAccount types.String   `tfsdk:"destination_account"`
+	DestinationRegion  types.String   `tfsdk:"destination_region"`
+	FrameworkID        types.String   `tfsdk:"framework_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Status             types.String   `tfsdk:"status"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // refreshFromOutput writes state data from an AWS response object
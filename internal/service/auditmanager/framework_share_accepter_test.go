@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerFrameworkShareAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var frameworkShare types.AssessmentFrameworkShareRequest
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_framework_share_accepter.test"
+	shareResourceName := "aws_auditmanager_framework_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckFrameworkShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkShareAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFrameworkShareAccepterExists(ctx, resourceName, &frameworkShare),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, shareResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "share_request_id", shareResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "framework_id", shareResourceName, "framework_id"),
+					resource.TestCheckResourceAttr(resourceName, "framework_name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "source_account"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ShareRequestStatusActive)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrStatus},
+			},
+		},
+	})
+}
+
+func testAccCheckFrameworkShareAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_auditmanager_framework_share_accepter" {
+				continue
+			}
+
+			_, err := tfauditmanager.FindFrameworkShareByTwoPartKey(ctx, conn, rs.Primary.ID, types.ShareRequestTypeReceived)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.AuditManager, create.ErrActionCheckingDestroyed, tfauditmanager.ResNameFrameworkShareAccepter, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFrameworkShareAccepterExists(ctx context.Context, name string, frameworkShare *types.AssessmentFrameworkShareRequest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameFrameworkShareAccepter, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
+		resp, err := tfauditmanager.FindFrameworkShareByTwoPartKey(ctx, conn, rs.Primary.ID, types.ShareRequestTypeReceived)
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameFrameworkShareAccepter, rs.Primary.ID, err)
+		}
+
+		*frameworkShare = *resp
+
+		return nil
+	}
+}
+
+// The framework is shared from the alternate region to the primary region, where it is accepted.
+func testAccFrameworkShareAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_auditmanager_control" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+
+resource "aws_auditmanager_framework" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  control_sets {
+    name = %[1]q
+    controls {
+      id = aws_auditmanager_control.test.id
+    }
+  }
+}
+
+resource "aws_auditmanager_framework_share" "test" {
+  provider = "awsalternate"
+
+  destination_account = data.aws_caller_identity.current.account_id
+  destination_region  = data.aws_region.current.name
+  framework_id        = aws_auditmanager_framework.test.id
+}
+
+resource "aws_auditmanager_framework_share_accepter" "test" {
+  share_request_id = aws_auditmanager_framework_share.test.id
+}
+`, rName))
+}
@@ -61,6 +61,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceFrameworkShare,
 		},
+		{
+			Factory: newResourceFrameworkShareAccepter,
+		},
 		{
 			Factory: newResourceOrganizationAdminAccountRegistration,
 		},
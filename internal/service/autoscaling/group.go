@@ -318,6 +318,14 @@ func resourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"instance_refresh_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_refresh_percentage_complete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"launch_configuration": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1292,6 +1300,23 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
 	}
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		instanceRefresh, err := findActiveInstanceRefreshByGroupName(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("instance_refresh_id", nil)
+			d.Set("instance_refresh_percentage_complete", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			d.Set("instance_refresh_id", instanceRefresh.InstanceRefreshId)
+			d.Set("instance_refresh_percentage_complete", instanceRefresh.PercentageComplete)
+		}
+	} else {
+		d.Set("instance_refresh_id", nil)
+		d.Set("instance_refresh_percentage_complete", nil)
+	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set(names.AttrLaunchTemplate, []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
@@ -2119,6 +2144,34 @@ func findInstanceRefreshes(ctx context.Context, conn *autoscaling.Client, input
 	return output, nil
 }
 
+// findActiveInstanceRefreshByGroupName returns the instance refresh that is currently in progress for the specified group.
+// A group can have at most one active instance refresh at a time.
+func findActiveInstanceRefreshByGroupName(ctx context.Context, conn *autoscaling.Client, name string) (*awstypes.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	output, err := findInstanceRefreshes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		switch v.Status {
+		case awstypes.InstanceRefreshStatusCancelling,
+			awstypes.InstanceRefreshStatusInProgress,
+			awstypes.InstanceRefreshStatusPending,
+			awstypes.InstanceRefreshStatusRollbackInProgress:
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func findLoadBalancerStates(ctx context.Context, conn *autoscaling.Client, name string) ([]awstypes.LoadBalancerState, error) {
 	input := &autoscaling.DescribeLoadBalancersInput{
 		AutoScalingGroupName: aws.String(name),
@@ -89,6 +89,8 @@ func TestAccAutoScalingGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "initial_lifecycle_hook.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "instance_maintenance_policy.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh_percentage_complete", acctest.Ct0),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", "aws_launch_configuration.test", names.AttrName),
 					resource.TestCheckResourceAttr(resourceName, "launch_template.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "load_balancers.#", acctest.Ct0),
@@ -1548,6 +1550,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					testAccCheckGroupExists(ctx, resourceName, &group),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, names.AttrName),
 					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh_id", ""),
 				),
 			},
 			{
@@ -1557,6 +1560,8 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, names.AttrName),
 					testAccCheckInstanceRefreshCount(ctx, &group, 1),
 					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusPending, awstypes.InstanceRefreshStatusInProgress),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_refresh_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_refresh_percentage_complete"),
 				),
 			},
 			{
@@ -5,6 +5,12 @@ package backup
 
 // Exports for use in tests only.
 var (
-	FindVaultAccessPolicyByName = findVaultAccessPolicyByName
-	FindVaultByName             = findVaultByName
+	ResourceRestoreTestingPlan      = resourceRestoreTestingPlan
+	ResourceRestoreTestingSelection = resourceRestoreTestingSelection
+
+	FindRestoreTestingPlanByName            = findRestoreTestingPlanByName
+	FindRestoreTestingSelectionByTwoPartKey = findRestoreTestingSelectionByTwoPartKey
+	FindVaultAccessPolicyByName             = findVaultAccessPolicyByName
+	FindVaultByName                         = findVaultByName
+	RestoreTestingSelectionParseResourceID  = restoreTestingSelectionParseResourceID
 )
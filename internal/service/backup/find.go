@@ -5,8 +5,10 @@ package backup
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -116,6 +118,42 @@ func findVaultByName(ctx context.Context, conn *backup.Client, name string) (*ba
 	return output, nil
 }
 
+// findVaultByARN describes a backup vault in the Region and account encoded in its ARN.
+func findVaultByARN(ctx context.Context, conn *backup.Client, vaultARN string) (*backup.DescribeBackupVaultOutput, error) {
+	parsedARN, err := arn.Parse(vaultARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimPrefix(parsedARN.Resource, "backup-vault:")
+	input := &backup.DescribeBackupVaultInput{
+		BackupVaultAccountId: aws.String(parsedARN.AccountID),
+		BackupVaultName:      aws.String(name),
+	}
+
+	output, err := conn.DescribeBackupVault(ctx, input, func(o *backup.Options) {
+		o.Region = parsedARN.Region
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findFrameworkByName(ctx context.Context, conn *backup.Client, name string) (*backup.DescribeFrameworkOutput, error) {
 	input := &backup.DescribeFrameworkInput{
 		FrameworkName: aws.String(name),
@@ -14,7 +14,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -157,6 +159,11 @@ func ResourcePlan() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"schedule_expression_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Etc/UTC",
+						},
 						"start_window": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -182,7 +189,10 @@ func ResourcePlan() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePlanCopyActionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -291,6 +301,63 @@ func resourcePlanDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// resourcePlanCopyActionCustomizeDiff checks copy actions whose destination is a logically air-gapped vault
+// against the vault's retention constraints so that misconfigurations are reported at plan time.
+func resourcePlanCopyActionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange(names.AttrRule) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	for _, vRule := range d.Get(names.AttrRule).(*schema.Set).List() {
+		mRule, ok := vRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		vCopyActions, ok := mRule["copy_action"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, vCopyAction := range vCopyActions.List() {
+			mCopyAction, ok := vCopyAction.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			vaultARN, ok := mCopyAction["destination_vault_arn"].(string)
+			if !ok || vaultARN == "" {
+				// Not yet known.
+				continue
+			}
+
+			vault, err := findVaultByARN(ctx, conn, vaultARN)
+
+			if err != nil {
+				// The destination vault may not exist yet or may not be visible to this account.
+				tflog.Debug(ctx, "skipping Backup Plan copy action validation", map[string]any{
+					"destination_vault_arn": vaultARN,
+					"error":                 err.Error(),
+				})
+				continue
+			}
+
+			var lifecycle map[string]interface{}
+			if v, ok := mCopyAction["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				lifecycle = v[0].(map[string]interface{})
+			}
+
+			if err := validateCopyActionLifecycleForVault(vault, lifecycle); err != nil {
+				return fmt.Errorf("rule (%s) copy_action (%s): %w", mRule["rule_name"], vaultARN, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func FindPlanByID(ctx context.Context, conn *backup.Client, id string) (*backup.GetBackupPlanOutput, error) {
 	input := &backup.GetBackupPlanInput{
 		BackupPlanId: aws.String(id),
@@ -335,6 +402,9 @@ func expandPlanRules(ctx context.Context, vRules *schema.Set) []awstypes.BackupR
 		if vSchedule, ok := mRule[names.AttrSchedule].(string); ok && vSchedule != "" {
 			rule.ScheduleExpression = aws.String(vSchedule)
 		}
+		if v, ok := mRule["schedule_expression_timezone"].(string); ok && v != "" {
+			rule.ScheduleExpressionTimezone = aws.String(v)
+		}
 		if vEnableContinuousBackup, ok := mRule["enable_continuous_backup"].(bool); ok {
 			rule.EnableContinuousBackup = aws.Bool(vEnableContinuousBackup)
 		}
@@ -436,13 +506,14 @@ func flattenPlanRules(ctx context.Context, rules []awstypes.BackupRule) *schema.
 
 	for _, rule := range rules {
 		mRule := map[string]interface{}{
-			"rule_name":                aws.ToString(rule.RuleName),
-			"target_vault_name":        aws.ToString(rule.TargetBackupVaultName),
-			names.AttrSchedule:         aws.ToString(rule.ScheduleExpression),
-			"enable_continuous_backup": aws.ToBool(rule.EnableContinuousBackup),
-			"start_window":             int(aws.ToInt64(rule.StartWindowMinutes)),
-			"completion_window":        int(aws.ToInt64(rule.CompletionWindowMinutes)),
-			"recovery_point_tags":      KeyValueTags(ctx, rule.RecoveryPointTags).IgnoreAWS().Map(),
+			"rule_name":                    aws.ToString(rule.RuleName),
+			"target_vault_name":            aws.ToString(rule.TargetBackupVaultName),
+			names.AttrSchedule:             aws.ToString(rule.ScheduleExpression),
+			"schedule_expression_timezone": aws.ToString(rule.ScheduleExpressionTimezone),
+			"enable_continuous_backup":     aws.ToBool(rule.EnableContinuousBackup),
+			"start_window":                 int(aws.ToInt64(rule.StartWindowMinutes)),
+			"completion_window":            int(aws.ToInt64(rule.CompletionWindowMinutes)),
+			"recovery_point_tags":          KeyValueTags(ctx, rule.RecoveryPointTags).IgnoreAWS().Map(),
 		}
 
 		if lifecycle := rule.Lifecycle; lifecycle != nil {
@@ -522,6 +593,9 @@ func planHash(vRule interface{}) int {
 	if v, ok := mRule[names.AttrSchedule].(string); ok {
 		buf.WriteString(fmt.Sprintf("%s-", v))
 	}
+	if v, ok := mRule["schedule_expression_timezone"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
 	if v, ok := mRule["enable_continuous_backup"].(bool); ok {
 		buf.WriteString(fmt.Sprintf("%t-", v))
 	}
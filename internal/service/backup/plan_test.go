@@ -40,10 +40,11 @@ func TestAccBackupPlan_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
-						"rule_name":         rName,
-						"target_vault_name": rName,
-						names.AttrSchedule:  "cron(0 12 * * ? *)",
-						"lifecycle.#":       acctest.Ct0,
+						"rule_name":                    rName,
+						"target_vault_name":            rName,
+						names.AttrSchedule:             "cron(0 12 * * ? *)",
+						"schedule_expression_timezone": "Etc/UTC",
+						"lifecycle.#":                  acctest.Ct0,
 					}),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
@@ -651,6 +652,51 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_scheduleExpressionTimezone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plan backup.GetBackupPlanOutput
+	resourceName := "aws_backup_plan.test"
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_scheduleExpressionTimezone(rName, "Pacific/Tahiti"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":                    rName,
+						names.AttrSchedule:             "cron(0 12 * * ? *)",
+						"schedule_expression_timezone": "Pacific/Tahiti",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_scheduleExpressionTimezone(rName, "Europe/Paris"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":                    rName,
+						names.AttrSchedule:             "cron(0 12 * * ? *)",
+						"schedule_expression_timezone": "Europe/Paris",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var plan backup.GetBackupPlanOutput
@@ -1208,3 +1254,22 @@ resource "aws_backup_plan" "test" {
 }
 `, rName)
 }
+
+func testAccPlanConfig_scheduleExpressionTimezone(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name                    = %[1]q
+    target_vault_name            = aws_backup_vault.test.name
+    schedule                     = "cron(0 12 * * ? *)"
+    schedule_expression_timezone = %[2]q
+  }
+}
+`, rName, timezone)
+}
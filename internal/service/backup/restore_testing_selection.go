@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_restore_testing_selection", name="Restore Testing Selection")
+func resourceRestoreTestingSelection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRestoreTestingSelectionCreate,
+		ReadWithoutTimeout:   resourceRestoreTestingSelectionRead,
+		UpdateWithoutTimeout: resourceRestoreTestingSelectionUpdate,
+		DeleteWithoutTimeout: resourceRestoreTestingSelectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceRestoreTestingSelectionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrIAMRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARNCheck(iamRoleARNCheck),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"protected_resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protected_resource_conditions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"string_equals":     restoreTestingSelectionKeyValueSchema(),
+						"string_not_equals": restoreTestingSelectionKeyValueSchema(),
+					},
+				},
+			},
+			"protected_resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(restoreTestingProtectedResourceType_Values(), false),
+			},
+			"restore_metadata_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"restore_testing_plan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"validation_window_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 168),
+			},
+		},
+	}
+}
+
+func restoreTestingSelectionKeyValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrKey: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrValue: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceRestoreTestingSelectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	planName, name := d.Get("restore_testing_plan_name").(string), d.Get(names.AttrName).(string)
+	id := restoreTestingSelectionCreateResourceID(planName, name)
+	input := &backup.CreateRestoreTestingSelectionInput{
+		RestoreTestingPlanName: aws.String(planName),
+		RestoreTestingSelection: &awstypes.RestoreTestingSelectionForCreate{
+			IamRoleArn:                  aws.String(d.Get(names.AttrIAMRoleARN).(string)),
+			ProtectedResourceType:       aws.String(d.Get("protected_resource_type").(string)),
+			RestoreTestingSelectionName: aws.String(name),
+		},
+	}
+
+	if v, ok := d.GetOk("protected_resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.RestoreTestingSelection.ProtectedResourceArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("protected_resource_conditions"); ok {
+		input.RestoreTestingSelection.ProtectedResourceConditions = expandProtectedResourceConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("restore_metadata_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		input.RestoreTestingSelection.RestoreMetadataOverrides = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("validation_window_hours"); ok {
+		input.RestoreTestingSelection.ValidationWindowHours = int32(v.(int))
+	}
+
+	_, err := conn.CreateRestoreTestingSelection(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Backup Restore Testing Selection (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceRestoreTestingSelectionRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingSelectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	selection, err := findRestoreTestingSelectionByTwoPartKey(ctx, conn, planName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Testing Selection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrIAMRoleARN, selection.IamRoleArn)
+	d.Set(names.AttrName, selection.RestoreTestingSelectionName)
+	d.Set("protected_resource_arns", selection.ProtectedResourceArns)
+	if err := d.Set("protected_resource_conditions", flattenProtectedResourceConditions(selection.ProtectedResourceConditions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting protected_resource_conditions: %s", err)
+	}
+	d.Set("protected_resource_type", selection.ProtectedResourceType)
+	d.Set("restore_metadata_overrides", selection.RestoreMetadataOverrides)
+	d.Set("restore_testing_plan_name", selection.RestoreTestingPlanName)
+	d.Set("validation_window_hours", selection.ValidationWindowHours)
+
+	return diags
+}
+
+func resourceRestoreTestingSelectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &backup.UpdateRestoreTestingSelectionInput{
+		RestoreTestingPlanName: aws.String(planName),
+		RestoreTestingSelection: &awstypes.RestoreTestingSelectionForUpdate{
+			IamRoleArn:                  aws.String(d.Get(names.AttrIAMRoleARN).(string)),
+			ProtectedResourceArns:       flex.ExpandStringValueSet(d.Get("protected_resource_arns").(*schema.Set)),
+			ProtectedResourceConditions: expandProtectedResourceConditions(d.Get("protected_resource_conditions").([]interface{})),
+			RestoreMetadataOverrides:    flex.ExpandStringValueMap(d.Get("restore_metadata_overrides").(map[string]interface{})),
+			ValidationWindowHours:       int32(d.Get("validation_window_hours").(int)),
+		},
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	_, err = conn.UpdateRestoreTestingSelection(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRestoreTestingSelectionRead(ctx, d, meta)...)
+}
+
+func resourceRestoreTestingSelectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupClient(ctx)
+
+	planName, name, err := restoreTestingSelectionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Restore Testing Selection: %s", d.Id())
+	_, err = conn.DeleteRestoreTestingSelection(ctx, &backup.DeleteRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Restore Testing Selection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceRestoreTestingSelectionCustomizeDiff enforces the API's rules for combining protected resource ARNs and conditions:
+// resources are selected either by explicit ARNs or by the "*" wildcard, and conditions can only narrow a wildcard selection.
+func resourceRestoreTestingSelectionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("protected_resource_arns") {
+		return nil
+	}
+
+	arns := flex.ExpandStringValueSet(d.Get("protected_resource_arns").(*schema.Set))
+	wildcard := slices.Contains(arns, "*")
+
+	if wildcard && len(arns) > 1 {
+		return errors.New(`"protected_resource_arns" cannot combine the "*" wildcard with specific resource ARNs`)
+	}
+
+	if v, ok := d.Get("protected_resource_conditions").([]interface{}); ok && len(v) > 0 && v[0] != nil && !wildcard {
+		return errors.New(`"protected_resource_conditions" can only be used when "protected_resource_arns" is ["*"]`)
+	}
+
+	return nil
+}
+
+func iamRoleARNCheck(v any, k string, a arn.ARN) (ws []string, errors []error) {
+	if a.Service != "iam" || !strings.HasPrefix(a.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid IAM role ARN", k, v))
+	}
+	return
+}
+
+const restoreTestingSelectionResourceIDPartCount = 2
+
+func restoreTestingSelectionCreateResourceID(planName, name string) string {
+	parts := []string{planName, name}
+	id, _ := flex.FlattenResourceId(parts, restoreTestingSelectionResourceIDPartCount, false)
+
+	return id
+}
+
+func restoreTestingSelectionParseResourceID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, restoreTestingSelectionResourceIDPartCount, false)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findRestoreTestingSelectionByTwoPartKey(ctx context.Context, conn *backup.Client, planName, name string) (*awstypes.RestoreTestingSelectionForGet, error) {
+	input := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(name),
+	}
+
+	output, err := conn.GetRestoreTestingSelection(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingSelection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingSelection, nil
+}
+
+func expandProtectedResourceConditions(tfList []interface{}) *awstypes.ProtectedResourceConditions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ProtectedResourceConditions{}
+
+	if v, ok := tfMap["string_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringEquals = expandKeyValues(v)
+	}
+
+	if v, ok := tfMap["string_not_equals"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StringNotEquals = expandKeyValues(v)
+	}
+
+	return apiObject
+}
+
+func expandKeyValues(tfList []interface{}) []awstypes.KeyValue {
+	var apiObjects []awstypes.KeyValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.KeyValue{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenProtectedResourceConditions(apiObject *awstypes.ProtectedResourceConditions) []interface{} {
+	if apiObject == nil || (len(apiObject.StringEquals) == 0 && len(apiObject.StringNotEquals) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"string_equals":     flattenKeyValues(apiObject.StringEquals),
+		"string_not_equals": flattenKeyValues(apiObject.StringNotEquals),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenKeyValues(apiObjects []awstypes.KeyValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -82,6 +82,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceRestoreTestingPlan,
+			TypeName: "aws_backup_restore_testing_plan",
+			Name:     "Restore Testing Plan",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceRestoreTestingSelection,
+			TypeName: "aws_backup_restore_testing_selection",
+			Name:     "Restore Testing Selection",
+		},
 		{
 			Factory:  ResourceSelection,
 			TypeName: "aws_backup_selection",
@@ -4,9 +4,13 @@
 package backup
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 )
 
 func validReportPlanName(v interface{}, k string) (ws []string, errors []error) {
@@ -25,3 +29,36 @@ func validFrameworkName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// validateCopyActionLifecycleForVault checks a copy action's lifecycle against the constraints of its destination vault.
+// Recovery points copied to a logically air-gapped vault must have a retention period within the vault's
+// minimum and maximum retention and cannot be moved to cold storage.
+func validateCopyActionLifecycleForVault(vault *backup.DescribeBackupVaultOutput, lifecycle map[string]interface{}) error {
+	if vault == nil || vault.VaultType != awstypes.VaultTypeLogicallyAirGappedBackupVault {
+		return nil
+	}
+
+	var coldStorageAfter, deleteAfter int
+	if lifecycle != nil {
+		coldStorageAfter, _ = lifecycle["cold_storage_after"].(int)
+		deleteAfter, _ = lifecycle["delete_after"].(int)
+	}
+
+	if coldStorageAfter != 0 {
+		return errors.New("lifecycle.cold_storage_after is not supported when copying to a logically air-gapped vault")
+	}
+
+	if deleteAfter == 0 {
+		return errors.New("lifecycle.delete_after is required when copying to a logically air-gapped vault")
+	}
+
+	if v := aws.ToInt64(vault.MinRetentionDays); v != 0 && int64(deleteAfter) < v {
+		return fmt.Errorf("lifecycle.delete_after (%d) must be at least the vault's minimum retention of %d days", deleteAfter, v)
+	}
+
+	if v := aws.ToInt64(vault.MaxRetentionDays); v != 0 && int64(deleteAfter) > v {
+		return fmt.Errorf("lifecycle.delete_after (%d) must be at most the vault's maximum retention of %d days", deleteAfter, v)
+	}
+
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -59,3 +62,71 @@ func TestValidFrameworkName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCopyActionLifecycleForVault(t *testing.T) {
+	t.Parallel()
+
+	standardVault := &backup.DescribeBackupVaultOutput{
+		VaultType: awstypes.VaultTypeBackupVault,
+	}
+	airGappedVault := &backup.DescribeBackupVaultOutput{
+		MaxRetentionDays: aws.Int64(365),
+		MinRetentionDays: aws.Int64(7),
+		VaultType:        awstypes.VaultTypeLogicallyAirGappedBackupVault,
+	}
+
+	testCases := map[string]struct {
+		vault       *backup.DescribeBackupVaultOutput
+		lifecycle   map[string]interface{}
+		expectError bool
+	}{
+		"standard vault without lifecycle": {
+			vault: standardVault,
+		},
+		"standard vault with cold storage": {
+			vault:     standardVault,
+			lifecycle: map[string]interface{}{"cold_storage_after": 30, "delete_after": 120},
+		},
+		"air-gapped vault within retention": {
+			vault:     airGappedVault,
+			lifecycle: map[string]interface{}{"cold_storage_after": 0, "delete_after": 30},
+		},
+		"air-gapped vault without lifecycle": {
+			vault:       airGappedVault,
+			expectError: true,
+		},
+		"air-gapped vault with cold storage": {
+			vault:       airGappedVault,
+			lifecycle:   map[string]interface{}{"cold_storage_after": 10, "delete_after": 100},
+			expectError: true,
+		},
+		"air-gapped vault below minimum retention": {
+			vault:       airGappedVault,
+			lifecycle:   map[string]interface{}{"cold_storage_after": 0, "delete_after": 1},
+			expectError: true,
+		},
+		"air-gapped vault above maximum retention": {
+			vault:       airGappedVault,
+			lifecycle:   map[string]interface{}{"cold_storage_after": 0, "delete_after": 400},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateCopyActionLifecycleForVault(testCase.vault, testCase.lifecycle)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -15,6 +15,151 @@ func (streamType) Values() []streamType {
 	}
 }
 
+type realtimeLogField string
+
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/real-time-logs.html#understand-real-time-log-config-fields.
+const (
+	realtimeLogFieldASN                            realtimeLogField = "asn"
+	realtimeLogFieldCCountry                       realtimeLogField = "c-country"
+	realtimeLogFieldCIP                            realtimeLogField = "c-ip"
+	realtimeLogFieldCIPVersion                     realtimeLogField = "c-ip-version"
+	realtimeLogFieldCPort                          realtimeLogField = "c-port"
+	realtimeLogFieldCacheBehaviorPathPattern       realtimeLogField = "cache-behavior-path-pattern"
+	realtimeLogFieldCMCDBufferLength               realtimeLogField = "cmcd-buffer-length"
+	realtimeLogFieldCMCDBufferStarvation           realtimeLogField = "cmcd-buffer-starvation"
+	realtimeLogFieldCMCDContentID                  realtimeLogField = "cmcd-content-id"
+	realtimeLogFieldCMCDDeadline                   realtimeLogField = "cmcd-deadline"
+	realtimeLogFieldCMCDEncodedBitrate             realtimeLogField = "cmcd-encoded-bitrate"
+	realtimeLogFieldCMCDMeasuredThroughput         realtimeLogField = "cmcd-measured-throughput"
+	realtimeLogFieldCMCDNextObjectRequest          realtimeLogField = "cmcd-next-object-request"
+	realtimeLogFieldCMCDNextRangeRequest           realtimeLogField = "cmcd-next-range-request"
+	realtimeLogFieldCMCDObjectDuration             realtimeLogField = "cmcd-object-duration"
+	realtimeLogFieldCMCDObjectType                 realtimeLogField = "cmcd-object-type"
+	realtimeLogFieldCMCDPlaybackRate               realtimeLogField = "cmcd-playback-rate"
+	realtimeLogFieldCMCDRequestedMaximumThroughput realtimeLogField = "cmcd-requested-maximum-throughput"
+	realtimeLogFieldCMCDSessionID                  realtimeLogField = "cmcd-session-id"
+	realtimeLogFieldCMCDStartup                    realtimeLogField = "cmcd-startup"
+	realtimeLogFieldCMCDStreamType                 realtimeLogField = "cmcd-stream-type"
+	realtimeLogFieldCMCDStreamingFormat            realtimeLogField = "cmcd-streaming-format"
+	realtimeLogFieldCMCDTopBitrate                 realtimeLogField = "cmcd-top-bitrate"
+	realtimeLogFieldCMCDVersion                    realtimeLogField = "cmcd-version"
+	realtimeLogFieldCSAccept                       realtimeLogField = "cs-accept"
+	realtimeLogFieldCSAcceptEncoding               realtimeLogField = "cs-accept-encoding"
+	realtimeLogFieldCSBytes                        realtimeLogField = "cs-bytes"
+	realtimeLogFieldCSCookie                       realtimeLogField = "cs-cookie"
+	realtimeLogFieldCSHeaderNames                  realtimeLogField = "cs-header-names"
+	realtimeLogFieldCSHeaders                      realtimeLogField = "cs-headers"
+	realtimeLogFieldCSHeadersCount                 realtimeLogField = "cs-headers-count"
+	realtimeLogFieldCSHost                         realtimeLogField = "cs-host"
+	realtimeLogFieldCSMethod                       realtimeLogField = "cs-method"
+	realtimeLogFieldCSProtocol                     realtimeLogField = "cs-protocol"
+	realtimeLogFieldCSProtocolVersion              realtimeLogField = "cs-protocol-version"
+	realtimeLogFieldCSReferer                      realtimeLogField = "cs-referer"
+	realtimeLogFieldCSURIQuery                     realtimeLogField = "cs-uri-query"
+	realtimeLogFieldCSURIStem                      realtimeLogField = "cs-uri-stem"
+	realtimeLogFieldCSUserAgent                    realtimeLogField = "cs-user-agent"
+	realtimeLogFieldFLEEncryptedFields             realtimeLogField = "fle-encrypted-fields"
+	realtimeLogFieldFLEStatus                      realtimeLogField = "fle-status"
+	realtimeLogFieldOriginFBL                      realtimeLogField = "origin-fbl"
+	realtimeLogFieldOriginLBL                      realtimeLogField = "origin-lbl"
+	realtimeLogFieldPrimaryDistributionDNS         realtimeLogField = "primary-distribution-dns-name"
+	realtimeLogFieldPrimaryDistributionID          realtimeLogField = "primary-distribution-id"
+	realtimeLogFieldRHost                          realtimeLogField = "r-host"
+	realtimeLogFieldSCBytes                        realtimeLogField = "sc-bytes"
+	realtimeLogFieldSCContentLen                   realtimeLogField = "sc-content-len"
+	realtimeLogFieldSCContentType                  realtimeLogField = "sc-content-type"
+	realtimeLogFieldSCRangeEnd                     realtimeLogField = "sc-range-end"
+	realtimeLogFieldSCRangeStart                   realtimeLogField = "sc-range-start"
+	realtimeLogFieldSCStatus                       realtimeLogField = "sc-status"
+	realtimeLogFieldSRReason                       realtimeLogField = "sr-reason"
+	realtimeLogFieldSSLCipher                      realtimeLogField = "ssl-cipher"
+	realtimeLogFieldSSLProtocol                    realtimeLogField = "ssl-protocol"
+	realtimeLogFieldTimeTaken                      realtimeLogField = "time-taken"
+	realtimeLogFieldTimeToFirstByte                realtimeLogField = "time-to-first-byte"
+	realtimeLogFieldTimestamp                      realtimeLogField = "timestamp"
+	realtimeLogFieldTimestampMs                    realtimeLogField = "timestamp(ms)"
+	realtimeLogFieldXEdgeDetailedResultType        realtimeLogField = "x-edge-detailed-result-type"
+	realtimeLogFieldXEdgeLocation                  realtimeLogField = "x-edge-location"
+	realtimeLogFieldXEdgeMQCS                      realtimeLogField = "x-edge-mqcs"
+	realtimeLogFieldXEdgeRequestID                 realtimeLogField = "x-edge-request-id"
+	realtimeLogFieldXEdgeResponseResultType        realtimeLogField = "x-edge-response-result-type"
+	realtimeLogFieldXEdgeResultType                realtimeLogField = "x-edge-result-type"
+	realtimeLogFieldXForwardedFor                  realtimeLogField = "x-forwarded-for"
+	realtimeLogFieldXHostHeader                    realtimeLogField = "x-host-header"
+)
+
+func (realtimeLogField) Values() []realtimeLogField {
+	return []realtimeLogField{
+		realtimeLogFieldASN,
+		realtimeLogFieldCCountry,
+		realtimeLogFieldCIP,
+		realtimeLogFieldCIPVersion,
+		realtimeLogFieldCPort,
+		realtimeLogFieldCacheBehaviorPathPattern,
+		realtimeLogFieldCMCDBufferLength,
+		realtimeLogFieldCMCDBufferStarvation,
+		realtimeLogFieldCMCDContentID,
+		realtimeLogFieldCMCDDeadline,
+		realtimeLogFieldCMCDEncodedBitrate,
+		realtimeLogFieldCMCDMeasuredThroughput,
+		realtimeLogFieldCMCDNextObjectRequest,
+		realtimeLogFieldCMCDNextRangeRequest,
+		realtimeLogFieldCMCDObjectDuration,
+		realtimeLogFieldCMCDObjectType,
+		realtimeLogFieldCMCDPlaybackRate,
+		realtimeLogFieldCMCDRequestedMaximumThroughput,
+		realtimeLogFieldCMCDSessionID,
+		realtimeLogFieldCMCDStartup,
+		realtimeLogFieldCMCDStreamType,
+		realtimeLogFieldCMCDStreamingFormat,
+		realtimeLogFieldCMCDTopBitrate,
+		realtimeLogFieldCMCDVersion,
+		realtimeLogFieldCSAccept,
+		realtimeLogFieldCSAcceptEncoding,
+		realtimeLogFieldCSBytes,
+		realtimeLogFieldCSCookie,
+		realtimeLogFieldCSHeaderNames,
+		realtimeLogFieldCSHeaders,
+		realtimeLogFieldCSHeadersCount,
+		realtimeLogFieldCSHost,
+		realtimeLogFieldCSMethod,
+		realtimeLogFieldCSProtocol,
+		realtimeLogFieldCSProtocolVersion,
+		realtimeLogFieldCSReferer,
+		realtimeLogFieldCSURIQuery,
+		realtimeLogFieldCSURIStem,
+		realtimeLogFieldCSUserAgent,
+		realtimeLogFieldFLEEncryptedFields,
+		realtimeLogFieldFLEStatus,
+		realtimeLogFieldOriginFBL,
+		realtimeLogFieldOriginLBL,
+		realtimeLogFieldPrimaryDistributionDNS,
+		realtimeLogFieldPrimaryDistributionID,
+		realtimeLogFieldRHost,
+		realtimeLogFieldSCBytes,
+		realtimeLogFieldSCContentLen,
+		realtimeLogFieldSCContentType,
+		realtimeLogFieldSCRangeEnd,
+		realtimeLogFieldSCRangeStart,
+		realtimeLogFieldSCStatus,
+		realtimeLogFieldSRReason,
+		realtimeLogFieldSSLCipher,
+		realtimeLogFieldSSLProtocol,
+		realtimeLogFieldTimeTaken,
+		realtimeLogFieldTimeToFirstByte,
+		realtimeLogFieldTimestamp,
+		realtimeLogFieldTimestampMs,
+		realtimeLogFieldXEdgeDetailedResultType,
+		realtimeLogFieldXEdgeLocation,
+		realtimeLogFieldXEdgeMQCS,
+		realtimeLogFieldXEdgeRequestID,
+		realtimeLogFieldXEdgeResponseResultType,
+		realtimeLogFieldXEdgeResultType,
+		realtimeLogFieldXForwardedFor,
+		realtimeLogFieldXHostHeader,
+	}
+}
+
 const (
 	distributionStatusDeployed   = "Deployed"
 	distributionStatusInProgress = "InProgress"
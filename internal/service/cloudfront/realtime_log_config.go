@@ -79,7 +79,10 @@ func resourceRealtimeLogConfig() *schema.Resource {
 			"fields": {
 				Type:     schema.TypeSet,
 				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[realtimeLogField](),
+				},
 			},
 			names.AttrName: {
 				Type:     schema.TypeString,
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -148,6 +149,65 @@ func TestAccCloudFrontRealtimeLogConfig_updates(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontRealtimeLogConfig_fields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RealtimeLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRealtimeLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRealtimeLogConfigConfig_fields(rName, `["timestamp", "c-ipp"]`),
+				ExpectError: regexache.MustCompile(`to be one of`),
+			},
+			{
+				Config: testAccRealtimeLogConfigConfig_fields(rName, `["timestamp", "cmcd-session-id", "r-host", "sr-reason", "x-edge-mqcs"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealtimeLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "5"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "cmcd-session-id"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "r-host"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "sr-reason"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "x-edge-mqcs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontRealtimeLogConfig_crossAccountStream(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RealtimeLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckRealtimeLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRealtimeLogConfigConfig_crossAccountStream(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealtimeLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.stream_arn", "aws_kinesis_stream.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRealtimeLogConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
@@ -293,3 +353,111 @@ resource "aws_cloudfront_realtime_log_config" "test" {
 }
 `, rName, samplingRate))
 }
+
+func testAccRealtimeLogConfigConfig_fields(rName, fields string) string {
+	return acctest.ConfigCompose(
+		testAccRealtimeLogBaseConfig(rName, 1),
+		fmt.Sprintf(`
+resource "aws_cloudfront_realtime_log_config" "test" {
+  name          = %[1]q
+  sampling_rate = 10
+  fields        = %[2]s
+
+  endpoint {
+    stream_type = "Kinesis"
+
+    kinesis_stream_config {
+      role_arn   = aws_iam_role.test[0].arn
+      stream_arn = aws_kinesis_stream.test[0].arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test[0]]
+}
+`, rName, fields))
+}
+
+func testAccRealtimeLogConfigConfig_crossAccountStream(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  provider = "awsalternate"
+
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_kinesis_resource_policy" "test" {
+  provider = "awsalternate"
+
+  resource_arn = aws_kinesis_stream.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = aws_iam_role.test.arn
+      }
+      Action = [
+        "kinesis:DescribeStreamSummary",
+        "kinesis:DescribeStream",
+        "kinesis:PutRecord",
+        "kinesis:PutRecords",
+      ]
+      Resource = aws_kinesis_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudfront.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "kinesis:DescribeStreamSummary",
+        "kinesis:DescribeStream",
+        "kinesis:PutRecord",
+        "kinesis:PutRecords",
+      ]
+      Resource = aws_kinesis_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_cloudfront_realtime_log_config" "test" {
+  name          = %[1]q
+  sampling_rate = 10
+  fields        = ["timestamp", "c-ip"]
+
+  endpoint {
+    stream_type = "Kinesis"
+
+    kinesis_stream_config {
+      role_arn   = aws_iam_role.test.arn
+      stream_arn = aws_kinesis_stream.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_kinesis_resource_policy.test]
+}
+`, rName))
+}
@@ -5,6 +5,8 @@ package cloudtrail
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,7 +47,16 @@ func resourceEventDataStore() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("federation_enabled").(bool) && d.NewValueKnown("federation_role_arn") && d.Get("federation_role_arn").(string) == "" {
+					return errors.New(`"federation_role_arn" is required when "federation_enabled" is true`)
+				}
+
+				return nil
+			},
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"advanced_event_selector": {
@@ -142,6 +154,17 @@ func resourceEventDataStore() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"federation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"federation_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			names.AttrKMSKeyID: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -216,6 +239,12 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store (%s) create: %s", name, err)
 	}
 
+	if d.Get("federation_enabled").(bool) {
+		if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEventDataStoreRead(ctx, d, meta)...)
 }
 
@@ -239,6 +268,8 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting advanced_event_selector: %s", err)
 	}
 	d.Set(names.AttrARN, output.EventDataStoreArn)
+	d.Set("federation_enabled", output.FederationStatus == types.FederationStatusEnabled)
+	d.Set("federation_role_arn", output.FederationRoleArn)
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
 	d.Set("multi_region_enabled", output.MultiRegionEnabled)
 	d.Set(names.AttrName, output.Name)
@@ -253,7 +284,7 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "federation_enabled", "federation_role_arn") {
 		input := &cloudtrail.UpdateEventDataStoreInput{
 			EventDataStore: aws.String(d.Id()),
 		}
@@ -293,6 +324,18 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChanges("federation_enabled", "federation_role_arn") {
+		if d.Get("federation_enabled").(bool) {
+			if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else {
+			if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceEventDataStoreRead(ctx, d, meta)...)
 }
 
@@ -320,6 +363,43 @@ func resourceEventDataStoreDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func enableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn, roleARN string, timeout time.Duration) error {
+	input := &cloudtrail.EnableFederationInput{
+		EventDataStore:    aws.String(arn),
+		FederationRoleArn: aws.String(roleARN),
+	}
+
+	_, err := conn.EnableFederation(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling CloudTrail Event Data Store (%s) federation: %w", arn, err)
+	}
+
+	if _, err := waitEventDataStoreFederationEnabled(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for CloudTrail Event Data Store (%s) federation enable: %w", arn, err)
+	}
+
+	return nil
+}
+
+func disableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) error {
+	input := &cloudtrail.DisableFederationInput{
+		EventDataStore: aws.String(arn),
+	}
+
+	_, err := conn.DisableFederation(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling CloudTrail Event Data Store (%s) federation: %w", arn, err)
+	}
+
+	if _, err := waitEventDataStoreFederationDisabled(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for CloudTrail Event Data Store (%s) federation disable: %w", arn, err)
+	}
+
+	return nil
+}
+
 func findEventDataStoreByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetEventDataStoreOutput, error) {
 	input := cloudtrail.GetEventDataStoreInput{
 		EventDataStore: aws.String(arn),
@@ -368,6 +448,22 @@ func statusEventDataStore(ctx context.Context, conn *cloudtrail.Client, arn stri
 	}
 }
 
+func statusEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEventDataStoreByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.FederationStatus), nil
+	}
+}
+
 func waitEventDataStoreAvailable(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.EventDataStoreStatusCreated),
@@ -401,3 +497,37 @@ func waitEventDataStoreDeleted(ctx context.Context, conn *cloudtrail.Client, arn
 
 	return nil, err
 }
+
+func waitEventDataStoreFederationEnabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusEnabling, types.FederationStatusDisabled),
+		Target:  enum.Slice(types.FederationStatusEnabled),
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEventDataStoreFederationDisabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusDisabling, types.FederationStatusEnabled),
+		Target:  enum.Slice(types.FederationStatusDisabled),
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudtrail_event_data_store_import", name="Event Data Store Import")
+func resourceEventDataStoreImport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventDataStoreImportCreate,
+		ReadWithoutTimeout:   resourceEventDataStoreImportRead,
+		DeleteWithoutTimeout: resourceEventDataStoreImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"end_event_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"import_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_access_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"s3_bucket_region": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"s3_location_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"import_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"failed_entries": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"files_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefixes_completed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefixes_found": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_event_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+		},
+	}
+}
+
+func resourceEventDataStoreImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	input := &cloudtrail.StartImportInput{
+		Destinations: flex.ExpandStringValueSet(d.Get("destinations").(*schema.Set)),
+		ImportSource: expandImportSource(d.Get("import_source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("end_event_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndEventTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("start_event_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartEventTime = aws.Time(v)
+	}
+
+	output, err := conn.StartImport(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CloudTrail Event Data Store Import: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.ImportId))
+
+	importOutput, err := waitImportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store Import (%s) complete: %s", d.Id(), err)
+	}
+
+	// The import completes even if some events can't be imported.
+	if v := importOutput.ImportStatistics; v != nil && aws.ToInt64(v.FailedEntries) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "CloudTrail Event Data Store Import (%s) completed with %d failed entries, use ListImportFailures for details", d.Id(), aws.ToInt64(v.FailedEntries))
+	}
+
+	return append(diags, resourceEventDataStoreImportRead(ctx, d, meta)...)
+}
+
+func resourceEventDataStoreImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	output, err := findImportByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Event Data Store Import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store Import (%s): %s", d.Id(), err)
+	}
+
+	if output.CreatedTimestamp != nil {
+		d.Set("created_timestamp", aws.ToTime(output.CreatedTimestamp).Format(time.RFC3339))
+	}
+	d.Set("destinations", output.Destinations)
+	if output.EndEventTime != nil {
+		d.Set("end_event_time", aws.ToTime(output.EndEventTime).Format(time.RFC3339))
+	}
+	if err := d.Set("import_source", flattenImportSource(output.ImportSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting import_source: %s", err)
+	}
+	if err := d.Set("import_statistics", flattenImportStatistics(output.ImportStatistics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting import_statistics: %s", err)
+	}
+	d.Set("import_status", output.ImportStatus)
+	if output.StartEventTime != nil {
+		d.Set("start_event_time", aws.ToTime(output.StartEventTime).Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func resourceEventDataStoreImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
+
+	// Events that have already been copied remain in the destination event data stores.
+	// Only an import that is still running can be stopped.
+	output, err := findImportByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store Import (%s): %s", d.Id(), err)
+	}
+
+	switch output.ImportStatus {
+	case types.ImportStatusInitializing, types.ImportStatusInProgress:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping CloudTrail Event Data Store Import: %s", d.Id())
+	_, err = conn.StopImport(ctx, &cloudtrail.StopImportInput{
+		ImportId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ImportNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping CloudTrail Event Data Store Import (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitImportStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store Import (%s) stop: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findImportByID(ctx context.Context, conn *cloudtrail.Client, id string) (*cloudtrail.GetImportOutput, error) {
+	input := &cloudtrail.GetImportInput{
+		ImportId: aws.String(id),
+	}
+
+	output, err := conn.GetImport(ctx, input)
+
+	if errs.IsA[*types.ImportNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusImport(ctx context.Context, conn *cloudtrail.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ImportStatus), nil
+	}
+}
+
+func waitImportCompleted(ctx context.Context, conn *cloudtrail.Client, id string, timeout time.Duration) (*cloudtrail.GetImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.ImportStatusInitializing, types.ImportStatusInProgress),
+		Target:       enum.Slice(types.ImportStatusCompleted),
+		Refresh:      statusImport(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetImportOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportStopped(ctx context.Context, conn *cloudtrail.Client, id string, timeout time.Duration) (*cloudtrail.GetImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ImportStatusInitializing, types.ImportStatusInProgress),
+		Target:  enum.Slice(types.ImportStatusStopped, types.ImportStatusCompleted, types.ImportStatusFailed),
+		Refresh: statusImport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetImportOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandImportSource(tfList []interface{}) *types.ImportSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ImportSource{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &types.S3ImportSource{
+			S3BucketAccessRoleArn: aws.String(tfMap["s3_bucket_access_role_arn"].(string)),
+			S3BucketRegion:        aws.String(tfMap["s3_bucket_region"].(string)),
+			S3LocationUri:         aws.String(tfMap["s3_location_uri"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenImportSource(apiObject *types.ImportSource) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"s3_bucket_access_role_arn": aws.ToString(v.S3BucketAccessRoleArn),
+			"s3_bucket_region":          aws.ToString(v.S3BucketRegion),
+			"s3_location_uri":           aws.ToString(v.S3LocationUri),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenImportStatistics(apiObject *types.ImportStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"events_completed":   aws.ToInt64(apiObject.EventsCompleted),
+		"failed_entries":     aws.ToInt64(apiObject.FailedEntries),
+		"files_completed":    aws.ToInt64(apiObject.FilesCompleted),
+		"prefixes_completed": aws.ToInt64(apiObject.PrefixesCompleted),
+		"prefixes_found":     aws.ToInt64(apiObject.PrefixesFound),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudTrailEventDataStoreImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreImportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventDataStoreImportExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "destinations.*", "aws_cloudtrail_event_data_store.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "import_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "import_source.0.s3.0.s3_bucket_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "import_source.0.s3.0.s3_bucket_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "import_statistics.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "import_status", "COMPLETED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventDataStoreImportExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudTrailClient(ctx)
+
+		_, err := tfcloudtrail.FindImportByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccEventDataStoreImportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:GetBucketAcl",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  termination_protection_enabled = false # For ease of deletion.
+}
+
+resource "aws_cloudtrail_event_data_store_import" "test" {
+  destinations = [aws_cloudtrail_event_data_store.test.arn]
+
+  import_source {
+    s3 {
+      s3_bucket_access_role_arn = aws_iam_role.test.arn
+      s3_bucket_region          = data.aws_region.current.name
+      s3_location_uri           = "s3://${aws_s3_bucket.test.bucket}/AWSLogs/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
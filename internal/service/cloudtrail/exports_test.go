@@ -5,10 +5,12 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
-	ResourceEventDataStore = resourceEventDataStore
-	ResourceTrail          = resourceTrail
+	ResourceEventDataStore       = resourceEventDataStore
+	ResourceEventDataStoreImport = resourceEventDataStoreImport
+	ResourceTrail                = resourceTrail
 
 	FindEventDataStoreByARN    = findEventDataStoreByARN
+	FindImportByID             = findImportByID
 	FindTrailByARN             = findTrailByARN
 	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
 )
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceEventDataStoreImport,
+			TypeName: "aws_cloudtrail_event_data_store_import",
+			Name:     "Event Data Store Import",
+		},
 	}
 }
 
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Compliance By Resource")
+func newComplianceByResourceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &complianceByResourceDataSource{}, nil
+}
+
+type complianceByResourceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*complianceByResourceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_config_compliance_by_resource"
+}
+
+func (d *complianceByResourceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compliance_by_resources": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[complianceByResourceModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[complianceByResourceModel](ctx),
+			},
+			"compliance_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.ComplianceType]()),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrResourceID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(names.AttrResourceType)),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *complianceByResourceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data complianceByResourceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConfigServiceClient(ctx)
+
+	input := &configservice.DescribeComplianceByResourceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findComplianceByResources(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ConfigService Compliance By Resource", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ComplianceByResources)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findComplianceByResources(ctx context.Context, conn *configservice.Client, input *configservice.DescribeComplianceByResourceInput) ([]awstypes.ComplianceByResource, error) {
+	var output []awstypes.ComplianceByResource
+
+	pages := configservice.NewDescribeComplianceByResourcePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ComplianceByResources...)
+	}
+
+	return output, nil
+}
+
+type complianceByResourceDataSourceModel struct {
+	ComplianceByResources fwtypes.ListNestedObjectValueOf[complianceByResourceModel] `tfsdk:"compliance_by_resources"`
+	ComplianceTypes       fwtypes.SetValueOf[types.String]                           `tfsdk:"compliance_types"`
+	ID                    types.String                                               `tfsdk:"id"`
+	ResourceID            types.String                                               `tfsdk:"resource_id"`
+	ResourceType          types.String                                               `tfsdk:"resource_type"`
+}
+
+type complianceByResourceModel struct {
+	Compliance   fwtypes.ListNestedObjectValueOf[complianceModel] `tfsdk:"compliance"`
+	ResourceID   types.String                                     `tfsdk:"resource_id"`
+	ResourceType types.String                                     `tfsdk:"resource_type"`
+}
+
+type complianceModel struct {
+	ComplianceContributorCount fwtypes.ListNestedObjectValueOf[complianceContributorCountModel] `tfsdk:"compliance_contributor_count"`
+	ComplianceType             fwtypes.StringEnum[awstypes.ComplianceType]                      `tfsdk:"compliance_type"`
+}
+
+type complianceContributorCountModel struct {
+	CapExceeded types.Bool  `tfsdk:"cap_exceeded"`
+	CappedCount types.Int64 `tfsdk:"capped_count"`
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccComplianceByResourceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_compliance_by_resource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceByResourceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_by_resources.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "compliance_types.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, "AWS::S3::Bucket"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceByResourceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+
+data "aws_config_compliance_by_resource" "test" {
+  resource_type    = "AWS::S3::Bucket"
+  compliance_types = ["COMPLIANT", "NON_COMPLIANT"]
+
+  depends_on = [aws_config_config_rule.test]
+}
+`, rName))
+}
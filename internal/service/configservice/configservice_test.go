@@ -14,6 +14,9 @@ func TestAccConfigService_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"ComplianceByResourceDataSource": {
+			acctest.CtBasic: testAccComplianceByResourceDataSource_basic,
+		},
 		"ConfigRule": {
 			acctest.CtBasic:      testAccConfigRule_basic,
 			"ownerAws":           testAccConfigRule_ownerAWS,
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Pending Aggregation Requests")
+func newPendingAggregationRequestsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &pendingAggregationRequestsDataSource{}, nil
+}
+
+type pendingAggregationRequestsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*pendingAggregationRequestsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_config_pending_aggregation_requests"
+}
+
+func (d *pendingAggregationRequestsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"pending_aggregation_requests": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pendingAggregationRequestModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[pendingAggregationRequestModel](ctx),
+			},
+		},
+	}
+}
+
+func (d *pendingAggregationRequestsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data pendingAggregationRequestsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConfigServiceClient(ctx)
+
+	output, err := findPendingAggregationRequests(ctx, conn, &configservice.DescribePendingAggregationRequestsInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ConfigService Pending Aggregation Requests", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.PendingAggregationRequests)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPendingAggregationRequests(ctx context.Context, conn *configservice.Client, input *configservice.DescribePendingAggregationRequestsInput) ([]awstypes.PendingAggregationRequest, error) {
+	var output []awstypes.PendingAggregationRequest
+
+	pages := configservice.NewDescribePendingAggregationRequestsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PendingAggregationRequests...)
+	}
+
+	return output, nil
+}
+
+type pendingAggregationRequestsDataSourceModel struct {
+	ID                         types.String                                                    `tfsdk:"id"`
+	PendingAggregationRequests fwtypes.ListNestedObjectValueOf[pendingAggregationRequestModel] `tfsdk:"pending_aggregation_requests"`
+}
+
+type pendingAggregationRequestModel struct {
+	RequesterAccountID types.String `tfsdk:"requester_account_id"`
+	RequesterAWSRegion types.String `tfsdk:"requester_aws_region"`
+}
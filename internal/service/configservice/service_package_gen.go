@@ -16,7 +16,16 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newComplianceByResourceDataSource,
+			Name:    "Compliance By Resource",
+		},
+		{
+			Factory: newPendingAggregationRequestsDataSource,
+			Name:    "Pending Aggregation Requests",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_controltower_baseline", name="Baseline")
+// @Tags(identifierAttribute="arn")
+func resourceBaseline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBaselineCreate,
+		ReadWithoutTimeout:   resourceBaselineRead,
+		UpdateWithoutTimeout: resourceBaselineUpdate,
+		DeleteWithoutTimeout: resourceBaselineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"baseline_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"baseline_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBaselineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	input := &controltower.EnableBaselineInput{
+		BaselineIdentifier: aws.String(d.Get("baseline_identifier").(string)),
+		BaselineVersion:    aws.String(d.Get("baseline_version").(string)),
+		Tags:               getTagsIn(ctx),
+		TargetIdentifier:   aws.String(d.Get("target_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 {
+		input.Parameters = expandEnabledBaselineParameters(v.([]interface{}))
+	}
+
+	output, err := conn.EnableBaseline(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ControlTower Baseline: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBaselineRead(ctx, d, meta)...)
+}
+
+func resourceBaselineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	output, err := findEnabledBaselineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ControlTower Baseline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Baseline (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("baseline_identifier", output.BaselineIdentifier)
+	d.Set("baseline_version", output.BaselineVersion)
+	parameters, err := flattenEnabledBaselineParameterSummaries(output.Parameters)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	if err := d.Set(names.AttrParameters, parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	d.Set("target_identifier", output.TargetIdentifier)
+
+	return diags
+}
+
+func resourceBaselineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	if d.HasChanges("baseline_version", names.AttrParameters) {
+		input := &controltower.UpdateEnabledBaselineInput{
+			BaselineVersion:           aws.String(d.Get("baseline_version").(string)),
+			EnabledBaselineIdentifier: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 {
+			input.Parameters = expandEnabledBaselineParameters(v.([]interface{}))
+		}
+
+		output, err := conn.UpdateEnabledBaseline(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ControlTower Baseline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBaselineRead(ctx, d, meta)...)
+}
+
+func resourceBaselineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	log.Printf("[DEBUG] Deleting ControlTower Baseline: %s", d.Id())
+	output, err := conn.DisableBaseline(ctx, &controltower.DisableBaselineInput{
+		EnabledBaselineIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ControlTower Baseline (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEnabledBaselineByID(ctx context.Context, conn *controltower.Client, id string) (*types.EnabledBaselineDetails, error) {
+	input := &controltower.GetEnabledBaselineInput{
+		EnabledBaselineIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetEnabledBaseline(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnabledBaselineDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnabledBaselineDetails, nil
+}
+
+func findBaselineOperationByID(ctx context.Context, conn *controltower.Client, id string) (*types.BaselineOperation, error) {
+	input := &controltower.GetBaselineOperationInput{
+		OperationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetBaselineOperation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BaselineOperation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BaselineOperation, nil
+}
+
+func statusBaselineOperation(ctx context.Context, conn *controltower.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBaselineOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitBaselineOperationSucceeded(ctx context.Context, conn *controltower.Client, id string, timeout time.Duration) (*types.BaselineOperation, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.BaselineOperationStatusInProgress),
+		Target:  enum.Slice(types.BaselineOperationStatusSucceeded),
+		Refresh: statusBaselineOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.BaselineOperation); ok {
+		if status := output.Status; status == types.BaselineOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEnabledBaselineParameters(tfList []interface{}) []types.EnabledBaselineParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.EnabledBaselineParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.EnabledBaselineParameter{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: document.NewLazyDocument(tfMap[names.AttrValue].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEnabledBaselineParameterSummaries(apiObjects []types.EnabledBaselineParameterSummary) ([]interface{}, error) {
+	if len(apiObjects) == 0 {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var value string
+
+		if apiObject.Value != nil {
+			// String values round-trip as-is; anything else is stored as its JSON encoding.
+			if err := apiObject.Value.UnmarshalSmithyDocument(&value); err != nil {
+				v, err := json.SmithyDocumentToString(apiObject.Value)
+
+				if err != nil {
+					return nil, err
+				}
+
+				value = v
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: value,
+		})
+	}
+
+	return tfList, nil
+}
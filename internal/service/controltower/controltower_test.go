@@ -13,11 +13,19 @@ func TestAccControlTower_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"Baseline": {
+			acctest.CtBasic:      testAccBaseline_basic,
+			acctest.CtDisappears: testAccBaseline_disappears,
+		},
 		"LandingZone": {
 			acctest.CtBasic:      testAccLandingZone_basic,
 			acctest.CtDisappears: testAccLandingZone_disappears,
+			"resetOnDrift":       testAccLandingZone_resetOnDrift,
 			"tags":               testAccLandingZone_tags,
 		},
+		"LandingZoneDataSource": {
+			acctest.CtBasic: testAccLandingZoneDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
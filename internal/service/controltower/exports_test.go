@@ -5,9 +5,11 @@ package controltower
 
 // Exports for use in tests only.
 var (
+	ResourceBaseline    = resourceBaseline
 	ResourceControl     = resourceControl
 	ResourceLandingZone = resourceLandingZone
 
+	FindEnabledBaselineByID        = findEnabledBaselineByID
 	FindEnabledControlByTwoPartKey = findEnabledControlByTwoPartKey
 	FindLandingZoneByID            = findLandingZoneByID
 )
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
 	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -41,7 +42,11 @@ func resourceLandingZone() *schema.Resource {
 		DeleteWithoutTimeout: resourceLandingZoneDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("reset_on_drift", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -82,6 +87,11 @@ func resourceLandingZone() *schema.Resource {
 					return json
 				},
 			},
+			"reset_on_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -90,8 +100,24 @@ func resourceLandingZone() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceLandingZoneCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// resourceLandingZoneCustomizeDiff plans an update that resets a drifted landing zone when reset_on_drift is set.
+func resourceLandingZoneCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("reset_on_drift").(bool) {
+		return nil
+	}
+
+	if landingZoneDrifted(d.Get("drift_status").([]interface{})) {
+		return d.SetNewComputed("drift_status")
 	}
+
+	return nil
 }
 
 func resourceLandingZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -174,7 +200,7 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges("manifest_json", names.AttrVersion) {
 		manifest, err := json.SmithyDocumentFromString(d.Get("manifest_json").(string), document.NewLazyDocument)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -197,6 +223,28 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.Get("reset_on_drift").(bool) {
+		landingZone, err := findLandingZoneByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ControlTower Landing Zone (%s): %s", d.Id(), err)
+		}
+
+		if landingZone.DriftStatus != nil && landingZone.DriftStatus.Status == types.LandingZoneDriftStatusDrifted {
+			output, err := conn.ResetLandingZone(ctx, &controltower.ResetLandingZoneInput{
+				LandingZoneIdentifier: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting ControlTower Landing Zone (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) reset: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceLandingZoneRead(ctx, d, meta)...)
 }
 
@@ -328,3 +376,13 @@ func flattenLandingZoneDriftStatusSummary(apiObject *types.LandingZoneDriftStatu
 
 	return tfMap
 }
+
+func landingZoneDrifted(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return tfMap[names.AttrStatus].(string) == string(types.LandingZoneDriftStatusDrifted)
+}
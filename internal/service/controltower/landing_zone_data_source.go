@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_controltower_landing_zone", name="Landing Zone")
+func dataSourceLandingZone() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLandingZoneRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"landing_zone_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"latest_available_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLandingZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	var id string
+	if v, ok := d.GetOk("landing_zone_identifier"); ok {
+		id = v.(string)
+	} else {
+		landingZone, err := findLandingZone(ctx, conn, &controltower.ListLandingZonesInput{})
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ControlTower Landing Zone", err))
+		}
+
+		id, err = landingZoneIDFromARN(aws.ToString(landingZone.Arn))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	landingZone, err := findLandingZoneByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Landing Zone (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, landingZone.Arn)
+	if landingZone.DriftStatus != nil {
+		if err := d.Set("drift_status", []interface{}{flattenLandingZoneDriftStatusSummary(landingZone.DriftStatus)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting drift_status: %s", err)
+		}
+	} else {
+		d.Set("drift_status", nil)
+	}
+	d.Set("landing_zone_identifier", id)
+	d.Set("latest_available_version", landingZone.LatestAvailableVersion)
+	if landingZone.Manifest != nil {
+		v, err := json.SmithyDocumentToString(landingZone.Manifest)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("manifest_json", v)
+	} else {
+		d.Set("manifest_json", nil)
+	}
+	d.Set(names.AttrStatus, landingZone.Status)
+	d.Set(names.AttrVersion, landingZone.Version)
+
+	return diags
+}
+
+func findLandingZone(ctx context.Context, conn *controltower.Client, input *controltower.ListLandingZonesInput) (*types.LandingZoneSummary, error) {
+	output, err := findLandingZones(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLandingZones(ctx context.Context, conn *controltower.Client, input *controltower.ListLandingZonesInput) ([]types.LandingZoneSummary, error) {
+	var output []types.LandingZoneSummary
+
+	pages := controltower.NewListLandingZonesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LandingZones...)
+	}
+
+	return output, nil
+}
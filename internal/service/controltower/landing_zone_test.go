@@ -128,6 +128,47 @@ func testAccLandingZone_tags(t *testing.T) {
 	})
 }
 
+func testAccLandingZone_resetOnDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_controltower_landing_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckNoLandingZone(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckLandingZoneDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_resetOnDrift(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLandingZoneExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "drift_status.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "drift_status.0.status", "IN_SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "reset_on_drift", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_on_drift"},
+			},
+			{
+				Config: testAccLandingZoneConfig_resetOnDrift(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLandingZoneExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reset_on_drift", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckNoLandingZone(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
 
@@ -228,3 +269,15 @@ resource "aws_controltower_landing_zone" "test" {
 }
 `, acctest.Region(), landingZoneVersion, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccLandingZoneConfig_resetOnDrift(resetOnDrift bool) string {
+	return fmt.Sprintf(`
+resource "aws_controltower_landing_zone" "test" {
+  manifest_json = file("${path.module}/test-fixtures/LandingZoneManifest.json")
+
+  version = %[2]q
+
+  reset_on_drift = %[3]t
+}
+`, acctest.Region(), landingZoneVersion, resetOnDrift)
+}
@@ -30,11 +30,24 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_controltower_controls",
 			Name:     "Control",
 		},
+		{
+			Factory:  dataSourceLandingZone,
+			TypeName: "aws_controltower_landing_zone",
+			Name:     "Landing Zone",
+		},
 	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBaseline,
+			TypeName: "aws_controltower_baseline",
+			Name:     "Baseline",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceControl,
 			TypeName: "aws_controltower_control",
@@ -94,6 +94,63 @@ func resourceTask() *schema.Resource {
 					},
 				},
 			},
+			"manifest_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestAction](),
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestFormat](),
+						},
+						names.AttrSource: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_access_role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"manifest_object_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"manifest_object_version_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_bucket_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -329,6 +386,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Includes = expandFilterRules(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("manifest_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestConfig = expandManifestConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrName); ok {
 		input.Name = aws.String(v.(string))
 	}
@@ -381,6 +442,13 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("includes", flattenFilterRules(output.Includes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting includes: %s", err)
 	}
+	if output.ManifestConfig != nil {
+		if err := d.Set("manifest_config", []interface{}{flattenManifestConfig(output.ManifestConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting manifest_config: %s", err)
+		}
+	} else {
+		d.Set("manifest_config", nil)
+	}
 	d.Set(names.AttrName, output.Name)
 	if err := d.Set("options", flattenOptions(output.Options)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting options: %s", err)
@@ -417,6 +485,15 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Includes = expandFilterRules(d.Get("includes").([]interface{}))
 		}
 
+		if d.HasChanges("manifest_config") {
+			if v, ok := d.GetOk("manifest_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ManifestConfig = expandManifestConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// An empty manifest configuration removes it from the task.
+				input.ManifestConfig = &awstypes.ManifestConfig{}
+			}
+		}
+
 		if d.HasChanges(names.AttrName) {
 			input.Name = aws.String(d.Get(names.AttrName).(string))
 		}
@@ -549,6 +626,40 @@ func flattenOptions(options *awstypes.Options) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenManifestConfig(apiObject *awstypes.ManifestConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrAction: string(apiObject.Action),
+		names.AttrFormat: string(apiObject.Format),
+	}
+
+	if v := apiObject.Source; v != nil && v.S3 != nil {
+		tfMap[names.AttrSource] = []interface{}{map[string]interface{}{
+			"s3": []interface{}{flattenS3ManifestConfig(v.S3)},
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3ManifestConfig(apiObject *awstypes.S3ManifestConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_access_role_arn":     aws.ToString(apiObject.BucketAccessRoleArn),
+		"manifest_object_path":       aws.ToString(apiObject.ManifestObjectPath),
+		"manifest_object_version_id": aws.ToString(apiObject.ManifestObjectVersionId),
+		"s3_bucket_arn":              aws.ToString(apiObject.S3BucketArn),
+	}
+
+	return tfMap
+}
+
 func flattenTaskReportConfig(options *awstypes.TaskReportConfig) []interface{} {
 	if options == nil {
 		return []interface{}{}
@@ -665,6 +776,58 @@ func flattenTaskSchedule(schedule *awstypes.TaskSchedule) []interface{} {
 	return []interface{}{m}
 }
 
+func expandManifestConfig(tfMap map[string]interface{}) *awstypes.ManifestConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ManifestConfig{}
+
+	if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+		apiObject.Action = awstypes.ManifestAction(v)
+	}
+
+	if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+		apiObject.Format = awstypes.ManifestFormat(v)
+	}
+
+	if v, ok := tfMap[names.AttrSource].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Source = &awstypes.SourceManifestConfig{
+				S3: expandS3ManifestConfig(v[0].(map[string]interface{})),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestConfig(tfMap map[string]interface{}) *awstypes.S3ManifestConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.S3ManifestConfig{}
+
+	if v, ok := tfMap["bucket_access_role_arn"].(string); ok && v != "" {
+		apiObject.BucketAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_object_path"].(string); ok && v != "" {
+		apiObject.ManifestObjectPath = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_object_version_id"].(string); ok && v != "" {
+		apiObject.ManifestObjectVersionId = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_bucket_arn"].(string); ok && v != "" {
+		apiObject.S3BucketArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandTaskReportConfig(l []interface{}) *awstypes.TaskReportConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -847,6 +847,49 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncTask_manifestConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskConfig_manifestConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.action", "TRANSFER"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.0.s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_config.0.source.0.s3.0.bucket_access_role_arn", "aws_iam_role.manifest_test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_config.0.source.0.s3.0.manifest_object_path", "aws_s3_object.manifest_test", names.AttrKey),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_config.0.source.0.s3.0.s3_bucket_arn", "aws_s3_bucket.manifest_test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTaskConfig_manifestConfigRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task2),
+					testAccCheckTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncTask_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var task1, task2, task3 datasync.DescribeTaskOutput
@@ -1584,3 +1627,93 @@ resource "aws_datasync_task" "test" {
 }
 `, rName))
 }
+
+func testAccTaskConfig_baseManifest(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseLocationS3(rName),
+		testAccTaskConfig_baseLocationNFS(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "manifest_test" {
+  bucket        = "%[1]s-manifest-test"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "manifest_test" {
+  bucket  = aws_s3_bucket.manifest_test.bucket
+  key     = "manifest.csv"
+  content = "test/file1.txt"
+}
+
+resource "aws_iam_role" "manifest_test" {
+  name               = "%[1]s-manifest-test"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "datasync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "manifest_test" {
+  role   = aws_iam_role.manifest_test.id
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+	"Action": [
+	  "s3:GetObject",
+	  "s3:GetObjectVersion"
+	],
+	"Effect": "Allow",
+	"Resource": [
+	  "${aws_s3_bucket.manifest_test.arn}/*"
+	]
+  }]
+}
+POLICY
+}
+`, rName))
+}
+
+func testAccTaskConfig_manifestConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTaskConfig_baseManifest(rName), fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_nfs.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.test.arn
+
+  manifest_config {
+    action = "TRANSFER"
+    format = "CSV"
+
+    source {
+      s3 {
+        bucket_access_role_arn = aws_iam_role.manifest_test.arn
+        manifest_object_path   = aws_s3_object.manifest_test.key
+        s3_bucket_arn          = aws_s3_bucket.manifest_test.arn
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.manifest_test]
+}
+`, rName))
+}
+
+func testAccTaskConfig_manifestConfigRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccTaskConfig_baseManifest(rName), fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_nfs.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.test.arn
+}
+`, rName))
+}
@@ -7,5 +7,8 @@ package datazone
 var (
 	ResourceDomain                            = newResourceDomain
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
+	ResourceProjectMembership                 = newResourceProjectMembership
+	FindGlossaryTermByID                      = findGlossaryTermByID
+	FindProjectMembershipByIDs                = findProjectMembershipByIDs
 	IsResourceMissing                         = isResourceMissing
 )
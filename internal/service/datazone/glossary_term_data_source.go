@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Glossary Term")
+func newDataSourceGlossaryTerm(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceGlossaryTerm{}, nil
+}
+
+const (
+	DSNameGlossaryTerm = "Glossary Term Data Source"
+)
+
+type dataSourceGlossaryTerm struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceGlossaryTerm) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_datazone_glossary_term"
+}
+
+func (d *dataSourceGlossaryTerm) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"glossary_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"long_description": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"short_description": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GlossaryTermStatus](),
+				Computed:   true,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"updated_by": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"term_relations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[termRelationsData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"classifies": schema.ListAttribute{
+							Computed:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+						"is_a": schema.ListAttribute{
+							Computed:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceGlossaryTerm) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().DataZoneClient(ctx)
+
+	var data glossaryTermDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findGlossaryTermByID(ctx, conn, data.DomainIdentifier.ValueString(), data.Identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionReading, DSNameGlossaryTerm, data.Identifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findGlossaryTermByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetGlossaryTermOutput, error) {
+	in := &datazone.GetGlossaryTermInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetGlossaryTerm(ctx, in)
+
+	if isResourceMissing(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type glossaryTermDataSourceModel struct {
+	CreatedAt        timetypes.RFC3339                                  `tfsdk:"created_at"`
+	CreatedBy        types.String                                       `tfsdk:"created_by"`
+	DomainIdentifier types.String                                       `tfsdk:"domain_identifier"`
+	GlossaryID       types.String                                       `tfsdk:"glossary_id"`
+	ID               types.String                                       `tfsdk:"id"`
+	Identifier       types.String                                       `tfsdk:"identifier"`
+	LongDescription  types.String                                       `tfsdk:"long_description"`
+	Name             types.String                                       `tfsdk:"name"`
+	ShortDescription types.String                                       `tfsdk:"short_description"`
+	Status           fwtypes.StringEnum[awstypes.GlossaryTermStatus]    `tfsdk:"status"`
+	TermRelations    fwtypes.ListNestedObjectValueOf[termRelationsData] `tfsdk:"term_relations"`
+	UpdatedAt        timetypes.RFC3339                                  `tfsdk:"updated_at"`
+	UpdatedBy        types.String                                       `tfsdk:"updated_by"`
+}
+
+type termRelationsData struct {
+	Classifies fwtypes.ListValueOf[types.String] `tfsdk:"classifies"`
+	IsA        fwtypes.ListValueOf[types.String] `tfsdk:"is_a"`
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Glossaries and glossary terms cannot be created by this provider yet, so an existing term is supplied via an environment variable.
+const (
+	envVarDataZoneGlossaryTermID = "DATAZONE_GLOSSARY_TERM_ID"
+)
+
+func TestAccDataZoneGlossaryTermDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	glossaryTermID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneGlossaryTermID)
+	dataSourceName := "data.aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermDataSourceConfig_basic(domainID, glossaryTermID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_by"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_identifier", domainID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "glossary_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, glossaryTermID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossaryTermDataSource_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGlossaryTermDataSourceConfig_basic(domainID, "does-not-exist"),
+				ExpectError: regexache.MustCompile(`reading .*Glossary Term Data Source`),
+			},
+		},
+	})
+}
+
+func testAccGlossaryTermDataSourceConfig_basic(domainID, glossaryTermID string) string {
+	return fmt.Sprintf(`
+data "aws_datazone_glossary_term" "test" {
+  domain_identifier = %[1]q
+  identifier        = %[2]q
+}
+`, domainID, glossaryTermID)
+}
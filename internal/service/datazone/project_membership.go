@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Project Membership")
+func newResourceProjectMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProjectMembership{}
+	return r, nil
+}
+
+const (
+	ResNameProjectMembership = "Project Membership"
+
+	projectMembershipIDPartCount = 3
+)
+
+type resourceProjectMembership struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *resourceProjectMembership) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_project_membership"
+}
+
+func (r *resourceProjectMembership) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"designation": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserDesignation](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_identifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("group_identifier"),
+						path.MatchRoot("user_identifier"),
+					),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_identifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan projectMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the member's profile ID before creating the membership so that a failed lookup
+	// cannot leave a membership behind that isn't recorded in state.
+	memberID, err := findProjectMemberID(ctx, conn, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProjectMembership, plan.ProjectIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &datazone.CreateProjectMembershipInput{
+		Designation:       plan.Designation.ValueEnum(),
+		DomainIdentifier:  aws.String(plan.DomainIdentifier.ValueString()),
+		Member:            plan.member(),
+		ProjectIdentifier: aws.String(plan.ProjectIdentifier.ValueString()),
+	}
+
+	_, err = conn.CreateProjectMembership(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProjectMembership, plan.ProjectIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.MemberID = fwflex.StringValueToFramework(ctx, memberID)
+	plan.setID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceProjectMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state projectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	out, err := findProjectMembershipByIDs(ctx, conn, state.DomainIdentifier.ValueString(), state.ProjectIdentifier.ValueString(), state.MemberID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameProjectMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Designation = fwtypes.StringEnumValue(out.Designation)
+	switch v := out.MemberDetails.(type) {
+	case *awstypes.MemberDetailsMemberGroup:
+		if state.GroupIdentifier.IsNull() {
+			state.GroupIdentifier = fwflex.StringToFramework(ctx, v.Value.GroupId)
+		}
+	case *awstypes.MemberDetailsMemberUser:
+		if state.UserIdentifier.IsNull() {
+			state.UserIdentifier = fwflex.StringToFramework(ctx, v.Value.UserId)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state projectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteProjectMembershipInput{
+		DomainIdentifier:  aws.String(state.DomainIdentifier.ValueString()),
+		Member:            state.member(),
+		ProjectIdentifier: aws.String(state.ProjectIdentifier.ValueString()),
+	}
+
+	_, err := conn.DeleteProjectMembership(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameProjectMembership, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+// findProjectMemberID resolves the configured user or group identifier (e.g. an IAM ARN or IAM Identity Center ID)
+// to the DataZone profile ID that is returned when listing project memberships.
+func findProjectMemberID(ctx context.Context, conn *datazone.Client, data *projectMembershipResourceModel) (string, error) {
+	domainID := data.DomainIdentifier.ValueString()
+
+	if !data.GroupIdentifier.IsNull() {
+		out, err := conn.GetGroupProfile(ctx, &datazone.GetGroupProfileInput{
+			DomainIdentifier: aws.String(domainID),
+			GroupIdentifier:  aws.String(data.GroupIdentifier.ValueString()),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(out.Id), nil
+	}
+
+	out, err := conn.GetUserProfile(ctx, &datazone.GetUserProfileInput{
+		DomainIdentifier: aws.String(domainID),
+		UserIdentifier:   aws.String(data.UserIdentifier.ValueString()),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(out.Id), nil
+}
+
+func findProjectMembershipByIDs(ctx context.Context, conn *datazone.Client, domainID, projectID, memberID string) (*awstypes.ProjectMember, error) {
+	in := &datazone.ListProjectMembershipsInput{
+		DomainIdentifier:  aws.String(domainID),
+		ProjectIdentifier: aws.String(projectID),
+	}
+
+	pages := datazone.NewListProjectMembershipsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Members {
+			switch details := v.MemberDetails.(type) {
+			case *awstypes.MemberDetailsMemberGroup:
+				if aws.ToString(details.Value.GroupId) == memberID {
+					return &v, nil
+				}
+			case *awstypes.MemberDetailsMemberUser:
+				if aws.ToString(details.Value.UserId) == memberID {
+					return &v, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type projectMembershipResourceModel struct {
+	Designation       fwtypes.StringEnum[awstypes.UserDesignation] `tfsdk:"designation"`
+	DomainIdentifier  types.String                                 `tfsdk:"domain_identifier"`
+	GroupIdentifier   types.String                                 `tfsdk:"group_identifier"`
+	ID                types.String                                 `tfsdk:"id"`
+	MemberID          types.String                                 `tfsdk:"member_id"`
+	ProjectIdentifier types.String                                 `tfsdk:"project_identifier"`
+	UserIdentifier    types.String                                 `tfsdk:"user_identifier"`
+}
+
+func (data *projectMembershipResourceModel) member() awstypes.Member {
+	if !data.GroupIdentifier.IsNull() {
+		return &awstypes.MemberMemberGroupIdentifier{Value: data.GroupIdentifier.ValueString()}
+	}
+
+	return &awstypes.MemberMemberUserIdentifier{Value: data.UserIdentifier.ValueString()}
+}
+
+func (data *projectMembershipResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), projectMembershipIDPartCount, false)
+	if err != nil {
+		return fmt.Errorf("wrong format for import ID (%s), use: 'domain-id,project-id,member-id': %w", data.ID.ValueString(), err)
+	}
+
+	data.DomainIdentifier = types.StringValue(parts[0])
+	data.ProjectIdentifier = types.StringValue(parts[1])
+	data.MemberID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *projectMembershipResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DomainIdentifier.ValueString(), data.ProjectIdentifier.ValueString(), data.MemberID.ValueString()}, projectMembershipIDPartCount, false)))
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Project memberships require an existing project and a user known to the domain.
+// Neither can be created by this provider yet, so they are supplied via environment variables.
+const (
+	envVarDataZoneDomainID       = "DATAZONE_DOMAIN_ID"
+	envVarDataZoneProjectID      = "DATAZONE_PROJECT_ID"
+	envVarDataZoneUserIdentifier = "DATAZONE_USER_IDENTIFIER"
+)
+
+func TestAccDataZoneProjectMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	projectID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneProjectID)
+	userIdentifier := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneUserIdentifier)
+	resourceName := "aws_datazone_project_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(domainID, projectID, userIdentifier, "PROJECT_CONTRIBUTOR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "designation", "PROJECT_CONTRIBUTOR"),
+					resource.TestCheckResourceAttr(resourceName, "domain_identifier", domainID),
+					resource.TestCheckResourceAttrSet(resourceName, "member_id"),
+					resource.TestCheckResourceAttr(resourceName, "project_identifier", projectID),
+					resource.TestCheckResourceAttr(resourceName, "user_identifier", userIdentifier),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_identifier"},
+			},
+			{
+				Config: testAccProjectMembershipConfig_basic(domainID, projectID, userIdentifier, "PROJECT_OWNER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "designation", "PROJECT_OWNER"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneProjectMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	projectID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneProjectID)
+	userIdentifier := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneUserIdentifier)
+	resourceName := "aws_datazone_project_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(domainID, projectID, userIdentifier, "PROJECT_CONTRIBUTOR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceProjectMembership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_project_membership" {
+				continue
+			}
+
+			_, err := tfdatazone.FindProjectMembershipByIDs(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], rs.Primary.Attributes["member_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Project Membership %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectMembershipExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		_, err := tfdatazone.FindProjectMembershipByIDs(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], rs.Primary.Attributes["member_id"])
+
+		return err
+	}
+}
+
+func testAccProjectMembershipConfig_basic(domainID, projectID, userIdentifier, designation string) string {
+	return fmt.Sprintf(`
+resource "aws_datazone_project_membership" "test" {
+  designation        = %[4]q
+  domain_identifier  = %[1]q
+  project_identifier = %[2]q
+  user_identifier    = %[3]q
+}
+`, domainID, projectID, userIdentifier, designation)
+}
@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceEnvironmentBlueprint,
 			Name:    "Environment Blueprint",
 		},
+		{
+			Factory: newDataSourceGlossaryTerm,
+			Name:    "Glossary Term",
+		},
 	}
 }
 
@@ -37,6 +41,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceEnvironmentBlueprintConfiguration,
 			Name:    "Environment Blueprint Configuration",
 		},
+		{
+			Factory: newResourceProjectMembership,
+			Name:    "Project Membership",
+		},
 	}
 }
 
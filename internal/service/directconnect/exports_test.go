@@ -5,5 +5,6 @@ package directconnect
 
 // Exports for use in tests only.
 var (
-	ValidConnectionBandWidth = validConnectionBandWidth
+	ExpandGatewayAssociationAllowedPrefixesUpdates = expandGatewayAssociationAllowedPrefixesUpdates
+	ValidConnectionBandWidth                       = validConnectionBandWidth
 )
@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -50,6 +52,12 @@ func ResourceGatewayAssociation() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"allowed_prefixes_update_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"associated_gateway_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -212,31 +220,30 @@ func resourceGatewayAssociationUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
 
-	associationID := d.Get("dx_gateway_association_id").(string)
-	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
-		AssociationId: aws.String(associationID),
-	}
+	if d.HasChange("allowed_prefixes") {
+		associationID := d.Get("dx_gateway_association_id").(string)
+		oraw, nraw := d.GetChange("allowed_prefixes")
+		o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+		inputs := expandGatewayAssociationAllowedPrefixesUpdates(associationID, n.Difference(o).List(), o.Difference(n).List(), d.Get("allowed_prefixes_update_batch_size").(int))
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
 
-	oraw, nraw := d.GetChange("allowed_prefixes")
-	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+		// Only one update can be in progress at a time, so wait for each batch to complete before sending the next.
+		for i, input := range inputs {
+			log.Printf("[DEBUG] Updating Direct Connect Gateway Association (%d/%d): %s", i+1, len(inputs), input)
+			_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
 
-	if add := n.Difference(o); add.Len() > 0 {
-		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
-	}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+			}
 
-	if del := o.Difference(n); del.Len() > 0 {
-		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
-	}
+			output, err := waitGatewayAssociationUpdated(ctx, conn, associationID, time.Until(deadline))
 
-	log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
-	_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+			}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
-	}
-
-	if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+			log.Printf("[DEBUG] Direct Connect Gateway Association (%s) update %d/%d complete, state: %s", d.Id(), i+1, len(inputs), aws.StringValue(output.AssociationState))
+		}
 	}
 
 	return append(diags, resourceGatewayAssociationRead(ctx, d, meta)...)
@@ -292,3 +299,42 @@ func resourceGatewayAssociationImport(ctx context.Context, d *schema.ResourceDat
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// expandGatewayAssociationAllowedPrefixesUpdates returns the update requests needed to add and remove the specified allowed prefixes.
+// If batchSize is positive, prefixes are removed and then added at most batchSize at a time, otherwise a single request is returned.
+// Removing first means that swapping prefixes near the allowed prefix quota succeeds whenever the final set fits.
+func expandGatewayAssociationAllowedPrefixesUpdates(associationID string, add, del []interface{}, batchSize int) []*directconnect.UpdateDirectConnectGatewayAssociationInput {
+	if batchSize <= 0 {
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+		}
+
+		if len(add) > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add)
+		}
+
+		if len(del) > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del)
+		}
+
+		return []*directconnect.UpdateDirectConnectGatewayAssociationInput{input}
+	}
+
+	var inputs []*directconnect.UpdateDirectConnectGatewayAssociationInput
+
+	for _, chunk := range tfslices.Chunks(del, batchSize) {
+		inputs = append(inputs, &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+			RemoveAllowedPrefixesToDirectConnectGateway: expandRouteFilterPrefixes(chunk),
+		})
+	}
+
+	for _, chunk := range tfslices.Chunks(add, batchSize) {
+		inputs = append(inputs, &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AddAllowedPrefixesToDirectConnectGateway: expandRouteFilterPrefixes(chunk),
+			AssociationId:                            aws.String(associationID),
+		})
+	}
+
+	return inputs
+}
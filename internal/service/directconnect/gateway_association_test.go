@@ -6,6 +6,8 @@ package directconnect_test
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +22,64 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestExpandGatewayAssociationAllowedPrefixesUpdates(t *testing.T) {
+	t.Parallel()
+
+	// Each request is summarized as "+" or "-" followed by its prefixes.
+	summarize := func(inputs []*directconnect.UpdateDirectConnectGatewayAssociationInput) []string {
+		var got []string
+		for _, input := range inputs {
+			var parts []string
+			for _, v := range input.RemoveAllowedPrefixesToDirectConnectGateway {
+				parts = append(parts, "-"+aws.StringValue(v.Cidr))
+			}
+			for _, v := range input.AddAllowedPrefixesToDirectConnectGateway {
+				parts = append(parts, "+"+aws.StringValue(v.Cidr))
+			}
+			got = append(got, strings.Join(parts, " "))
+		}
+		return got
+	}
+
+	testCases := map[string]struct {
+		add, del  []interface{}
+		batchSize int
+		want      []string
+	}{
+		"no batching": {
+			add:       []interface{}{"10.0.2.0/24"},
+			del:       []interface{}{"10.0.1.0/24"},
+			batchSize: 0,
+			want:      []string{"-10.0.1.0/24 +10.0.2.0/24"},
+		},
+		"removals before additions": {
+			add:       []interface{}{"10.0.3.0/24", "10.0.4.0/24"},
+			del:       []interface{}{"10.0.1.0/24", "10.0.2.0/24"},
+			batchSize: 1,
+			want:      []string{"-10.0.1.0/24", "-10.0.2.0/24", "+10.0.3.0/24", "+10.0.4.0/24"},
+		},
+		"partial batch": {
+			add:       []interface{}{"10.0.3.0/24", "10.0.4.0/24", "10.0.5.0/24"},
+			del:       []interface{}{"10.0.1.0/24"},
+			batchSize: 2,
+			want:      []string{"-10.0.1.0/24", "+10.0.3.0/24 +10.0.4.0/24", "+10.0.5.0/24"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := summarize(tfdirectconnect.ExpandGatewayAssociationAllowedPrefixesUpdates("assoc-1", testCase.add, testCase.del, testCase.batchSize))
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("ExpandGatewayAssociationAllowedPrefixesUpdates() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
 // V0 state upgrade testing must be done via acceptance testing due to API call
 func TestAccDirectConnectGatewayAssociation_v0StateUpgrade(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -276,6 +336,43 @@ func TestAccDirectConnectGatewayAssociation_allowedPrefixesVPNGatewaySingleAccou
 	})
 }
 
+func TestAccDirectConnectGatewayAssociation_allowedPrefixesUpdateBatchSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dx_gateway_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	var ga directconnect.GatewayAssociation
+	var gap directconnect.GatewayAssociationProposal
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAssociationConfig_allowedPrefixesUpdateBatchSize(rName, rBgpAsn, `"10.255.255.0/30", "10.255.255.8/30"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationExists(ctx, resourceName, &ga, &gap),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes_update_batch_size", acctest.Ct1),
+				),
+			},
+			{
+				// Two removals and three additions are sent as five separate updates.
+				Config: testAccGatewayAssociationConfig_allowedPrefixesUpdateBatchSize(rName, rBgpAsn, `"10.255.254.0/30", "10.255.254.8/30", "10.255.254.16/30"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationExists(ctx, resourceName, &ga, &gap),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.254.0/30"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.254.8/30"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.254.16/30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectGatewayAssociation_allowedPrefixesVPNGatewayCrossAccount(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dx_gateway_association.test"
@@ -731,6 +828,20 @@ resource "aws_dx_gateway_association" "test" {
 `)
 }
 
+func testAccGatewayAssociationConfig_allowedPrefixesUpdateBatchSize(rName string, rBgpAsn int, allowedPrefixes string) string {
+	return acctest.ConfigCompose(
+		testAccGatewayAssociationConfigBase_vpnGatewaySingleAccount(rName, rBgpAsn),
+		fmt.Sprintf(`
+resource "aws_dx_gateway_association" "test" {
+  dx_gateway_id         = aws_dx_gateway.test.id
+  associated_gateway_id = aws_vpn_gateway_attachment.test.vpn_gateway_id
+
+  allowed_prefixes                   = [%[1]s]
+  allowed_prefixes_update_batch_size = 1
+}
+`, allowedPrefixes))
+}
+
 func testAccGatewayAssociationConfig_allowedPrefixesVPNCrossAccount(rName string, rBgpAsn int) string {
 	return acctest.ConfigCompose(
 		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
@@ -264,7 +264,7 @@ func resourceEBSSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta
 		return conn.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
 			SnapshotId: aws.String(d.Id()),
 		})
-	}, errCodeInvalidSnapshotInUse)
+	}, errCodeInvalidSnapshotInUse, errCodeRequestLimitExceeded)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
 		return diags
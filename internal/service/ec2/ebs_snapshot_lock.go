@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ebs_snapshot_lock", name="EBS Snapshot Lock")
+func newEBSSnapshotLockResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ebsSnapshotLockResource{}
+
+	return r, nil
+}
+
+type ebsSnapshotLockResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ebsSnapshotLockResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ebs_snapshot_lock"
+}
+
+func (r *ebsSnapshotLockResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cool_off_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 72),
+				},
+			},
+			"cool_off_period_expires_on": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expiration_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"lock_created_on": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lock_duration": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 36500),
+				},
+			},
+			"lock_duration_start_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lock_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LockMode](),
+				Required:   true,
+			},
+			"lock_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LockState](),
+				Computed:   true,
+			},
+			names.AttrSnapshotID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ebsSnapshotLockResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("expiration_date"),
+			path.MatchRoot("lock_duration"),
+		),
+	}
+}
+
+func (r *ebsSnapshotLockResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A cooling-off period can only be specified for snapshots locked in compliance mode.
+	if !data.CoolOffPeriod.IsNull() && !data.CoolOffPeriod.IsUnknown() && data.LockMode.ValueEnum() == awstypes.LockModeGovernance {
+		response.Diagnostics.AddAttributeError(
+			path.Root("cool_off_period"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("cool_off_period cannot be specified when lock_mode is %q", awstypes.LockModeGovernance),
+		)
+	}
+}
+
+func (r *ebsSnapshotLockResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	snapshotID := data.SnapshotID.ValueString()
+	input := data.lockSnapshotInput(ctx, true)
+
+	_, err := conn.LockSnapshot(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EBS Snapshot Lock (%s)", snapshotID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(snapshotID)
+
+	output, err := findLockedSnapshotByID(ctx, conn, snapshotID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EBS Snapshot Lock (%s)", snapshotID), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ebsSnapshotLockResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findLockedSnapshotByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EBS Snapshot Lock (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ebsSnapshotLockResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Once a compliance mode lock's cooling-off period has expired the cooling-off period must be omitted.
+	input := new.lockSnapshotInput(ctx, old.LockState.ValueEnum() != awstypes.LockStateCompliance)
+
+	_, err := conn.LockSnapshot(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EBS Snapshot Lock (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findLockedSnapshotByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EBS Snapshot Lock (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.flatten(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ebsSnapshotLockResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findLockedSnapshotByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EBS Snapshot Lock (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Snapshots locked in compliance mode whose cooling-off period has expired can't be unlocked by anyone.
+	// The lock is removed from state and expires on its own.
+	if output.LockState == awstypes.LockStateCompliance {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("EBS Snapshot Lock (%s) not unlocked", data.ID.ValueString()),
+			fmt.Sprintf("The snapshot is locked in compliance mode and its cooling-off period has expired. "+
+				"It can't be unlocked and remains locked until %s.", aws.ToTime(output.LockExpiresOn)),
+		)
+
+		return
+	}
+
+	_, err = conn.UnlockSnapshot(ctx, &ec2.UnlockSnapshotInput{
+		SnapshotId: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EBS Snapshot Lock (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type ebsSnapshotLockResourceModel struct {
+	CoolOffPeriod          types.Int64                            `tfsdk:"cool_off_period"`
+	CoolOffPeriodExpiresOn timetypes.RFC3339                      `tfsdk:"cool_off_period_expires_on"`
+	ExpirationDate         timetypes.RFC3339                      `tfsdk:"expiration_date"`
+	ID                     types.String                           `tfsdk:"id"`
+	LockCreatedOn          timetypes.RFC3339                      `tfsdk:"lock_created_on"`
+	LockDuration           types.Int64                            `tfsdk:"lock_duration"`
+	LockDurationStartTime  timetypes.RFC3339                      `tfsdk:"lock_duration_start_time"`
+	LockMode               fwtypes.StringEnum[awstypes.LockMode]  `tfsdk:"lock_mode"`
+	LockState              fwtypes.StringEnum[awstypes.LockState] `tfsdk:"lock_state"`
+	SnapshotID             types.String                           `tfsdk:"snapshot_id"`
+}
+
+// lockSnapshotInput returns the LockSnapshot input for the planned lock settings.
+// Exactly one of expiration_date and lock_duration is configured; the other is unknown in the plan.
+func (data *ebsSnapshotLockResourceModel) lockSnapshotInput(ctx context.Context, withCoolOffPeriod bool) *ec2.LockSnapshotInput {
+	input := &ec2.LockSnapshotInput{
+		LockMode:   data.LockMode.ValueEnum(),
+		SnapshotId: aws.String(data.SnapshotID.ValueString()),
+	}
+
+	if !data.ExpirationDate.IsNull() && !data.ExpirationDate.IsUnknown() {
+		input.ExpirationDate = fwflex.TimeFromFramework(ctx, data.ExpirationDate)
+	} else {
+		input.LockDuration = fwflex.Int32FromFramework(ctx, data.LockDuration)
+	}
+
+	if withCoolOffPeriod && input.LockMode == awstypes.LockModeCompliance && !data.CoolOffPeriod.IsUnknown() {
+		input.CoolOffPeriod = fwflex.Int32FromFramework(ctx, data.CoolOffPeriod)
+	}
+
+	return input
+}
+
+func (data *ebsSnapshotLockResourceModel) flatten(ctx context.Context, apiObject *awstypes.LockedSnapshotsInfo) {
+	data.CoolOffPeriod = fwflex.Int32ToFramework(ctx, apiObject.CoolOffPeriod)
+	data.CoolOffPeriodExpiresOn = fwflex.TimeToFramework(ctx, apiObject.CoolOffPeriodExpiresOn)
+	data.ExpirationDate = fwflex.TimeToFramework(ctx, apiObject.LockExpiresOn)
+	data.LockCreatedOn = fwflex.TimeToFramework(ctx, apiObject.LockCreatedOn)
+	data.LockDuration = fwflex.Int32ToFramework(ctx, apiObject.LockDuration)
+	data.LockDurationStartTime = fwflex.TimeToFramework(ctx, apiObject.LockDurationStartTime)
+	data.LockState = fwtypes.StringEnumValue(apiObject.LockState)
+	data.SnapshotID = fwflex.StringToFramework(ctx, apiObject.SnapshotId)
+
+	// The lock mode isn't returned, it's implied by the lock state.
+	switch apiObject.LockState {
+	case awstypes.LockStateGovernance:
+		data.LockMode = fwtypes.StringEnumValue(awstypes.LockModeGovernance)
+	case awstypes.LockStateCompliance, awstypes.LockStateComplianceCooloff:
+		data.LockMode = fwtypes.StringEnumValue(awstypes.LockModeCompliance)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotLock_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_snapshot_lock.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_governance(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "cool_off_period"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "lock_duration", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "governance"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSnapshotID, snapshotResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotLockConfig_governance(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lock_duration", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "governance"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotLock_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_snapshot_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_governance(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEBSSnapshotLock, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// Only compliance mode locks that are still within their cooling-off period can be unlocked,
+// so the test's snapshot can be deleted.
+func TestAccEC2EBSSnapshotLock_complianceCoolOff(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_snapshot_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_complianceCoolOff(rName, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cool_off_period", "24"),
+					resource.TestCheckResourceAttrSet(resourceName, "cool_off_period_expires_on"),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", "compliance"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "compliance-cooloff"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotLock_governanceCoolOff(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSSnapshotLockConfig_governanceCoolOff(rName),
+				ExpectError: regexache.MustCompile(`cool_off_period cannot be specified when lock_mode is "governance"`),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotLockDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ebs_snapshot_lock" {
+				continue
+			}
+
+			_, err := tfec2.FindLockedSnapshotByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EBS Snapshot Lock %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEBSSnapshotLockExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindLockedSnapshotByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccEBSSnapshotLockConfig_governance(rName string, lockDuration int) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id   = aws_ebs_snapshot.test.id
+  lock_mode     = "governance"
+  lock_duration = %[1]d
+}
+`, lockDuration))
+}
+
+func testAccEBSSnapshotLockConfig_complianceCoolOff(rName string, coolOffPeriod int) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id     = aws_ebs_snapshot.test.id
+  lock_mode       = "compliance"
+  lock_duration   = 1
+  cool_off_period = %[1]d
+}
+`, coolOffPeriod))
+}
+
+func testAccEBSSnapshotLockConfig_governanceCoolOff(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotConfig_basic(rName), `
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id     = aws_ebs_snapshot.test.id
+  lock_mode       = "governance"
+  lock_duration   = 1
+  cool_off_period = 1
+}
+`)
+}
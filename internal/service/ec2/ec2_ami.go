@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,6 +35,8 @@ const (
 	amiDeleteTimeout   = 90 * time.Minute
 	amiRetryDelay      = 5 * time.Second
 	amiRetryMinTimeout = 3 * time.Second
+
+	amiDeleteSnapshotsConcurrency = 10
 )
 
 // @SDKResource("aws_ami", name="AMI")
@@ -491,6 +494,16 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteAMI(ctx, d, meta, false)
+}
+
+// resourceAMIDeregisterOnlyDelete deletes an aws_ami_copy or aws_ami_from_instance, the resources that have the deregister_only argument.
+func resourceAMIDeregisterOnlyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteAMI(ctx, d, meta, d.Get("deregister_only").(bool))
+}
+
+// deleteAMI deregisters the AMI and, if the resource manages its EBS snapshots and deregisterOnly is false, deletes them.
+func deleteAMI(ctx context.Context, d *schema.ResourceData, meta interface{}, deregisterOnly bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
@@ -507,24 +520,16 @@ func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "deregistering EC2 AMI (%s): %s", d.Id(), err)
 	}
 
-	// If we're managing the EBS snapshots then we need to delete those too.
-	if d.Get("manage_ebs_snapshots").(bool) {
-		errs := map[string]error{}
-		ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
-		req := &ec2.DeleteSnapshotInput{}
-		for _, ebsBlockDevI := range ebsBlockDevsSet.List() {
-			ebsBlockDev := ebsBlockDevI.(map[string]interface{})
-			snapshotId := ebsBlockDev[names.AttrSnapshotID].(string)
-			if snapshotId != "" {
-				req.SnapshotId = aws.String(snapshotId)
-				_, err := conn.DeleteSnapshot(ctx, req)
-				if err != nil {
-					errs[snapshotId] = err
-				}
+	// If we're managing the EBS snapshots then we need to delete those too, unless asked to keep them.
+	if d.Get("manage_ebs_snapshots").(bool) && !deregisterOnly {
+		var snapshotIDs []string
+		for _, tfMapRaw := range d.Get("ebs_block_device").(*schema.Set).List() {
+			if v := tfMapRaw.(map[string]interface{})[names.AttrSnapshotID].(string); v != "" {
+				snapshotIDs = append(snapshotIDs, v)
 			}
 		}
 
-		if len(errs) > 0 {
+		if errs := deleteAMISnapshots(ctx, conn, snapshotIDs, d.Timeout(schema.TimeoutDelete)); len(errs) > 0 {
 			errParts := []string{"Errors while deleting associated EBS snapshots:"}
 			for snapshotId, err := range errs {
 				errParts = append(errParts, fmt.Sprintf("%s: %s", snapshotId, err))
@@ -541,6 +546,50 @@ func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+// deleteAMISnapshots deletes the specified EBS snapshots concurrently, retrying each deletion if it is throttled
+// or the snapshot is still in use by the deregistering image. Errors are returned keyed by snapshot ID.
+func deleteAMISnapshots(ctx context.Context, conn *ec2.Client, snapshotIDs []string, timeout time.Duration) map[string]error {
+	var (
+		errs = map[string]error{}
+		mu   sync.Mutex
+		sem  = make(chan struct{}, amiDeleteSnapshotsConcurrency)
+		wg   sync.WaitGroup
+	)
+
+	for _, snapshotID := range snapshotIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(snapshotID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			log.Printf("[INFO] Deleting EBS Snapshot: %s", snapshotID)
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+				return conn.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+					SnapshotId: aws.String(snapshotID),
+				})
+			}, errCodeRequestLimitExceeded, errCodeInvalidSnapshotInUse)
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+				return
+			}
+
+			if err != nil {
+				mu.Lock()
+				errs[snapshotID] = err
+				mu.Unlock()
+			}
+		}(snapshotID)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
 func updateDescription(ctx context.Context, conn *ec2.Client, id string, description string) error {
 	input := &ec2.ModifyImageAttributeInput{
 		Description: &awstypes.AttributeValue{
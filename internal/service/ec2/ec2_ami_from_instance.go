@@ -33,7 +33,7 @@ func resourceAMIFromInstance() *schema.Resource {
 		// since the aws_ami_from_instance resource only differs in how it's created.
 		ReadWithoutTimeout:   resourceAMIRead,
 		UpdateWithoutTimeout: resourceAMIUpdate,
-		DeleteWithoutTimeout: resourceAMIDelete,
+		DeleteWithoutTimeout: resourceAMIDeregisterOnlyDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(amiRetryTimeout),
@@ -62,6 +62,11 @@ func resourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregister_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
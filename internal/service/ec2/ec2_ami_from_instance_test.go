@@ -111,6 +111,37 @@ func TestAccEC2AMIFromInstance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIFromInstance_deregisterOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var image awstypes.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_from_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFromInstanceConfig_deregisterOnly(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deregister_only", acctest.CtTrue),
+				),
+			},
+			{
+				// Switch back so that the snapshots are deleted along with the AMI.
+				Config: testAccAMIFromInstanceConfig_deregisterOnly(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deregister_only", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccAMIFromInstanceBaseConfig(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -171,3 +202,16 @@ resource "aws_ami_from_instance" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccAMIFromInstanceConfig_deregisterOnly(rName string, deregisterOnly bool) string {
+	return acctest.ConfigCompose(
+		testAccAMIFromInstanceBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  description        = "Testing Terraform aws_ami_from_instance resource"
+  source_instance_id = aws_instance.test.id
+  deregister_only    = %[2]t
+}
+`, rName, deregisterOnly))
+}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_capacity_reservation_fleet", name="Capacity Reservation Fleet")
+// @Tags(identifierAttribute="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.CapacityReservationFleet")
+func newCapacityReservationFleetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &capacityReservationFleetResource{}
+
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type capacityReservationFleetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*capacityReservationFleetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_reservation_fleet"
+}
+
+func (r *capacityReservationFleetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allocation_strategy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("prioritized"),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"capacity_reservation_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_match_criteria": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FleetInstanceMatchCriteria](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationFleetState](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tenancy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FleetCapacityReservationTenancy](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_fulfilled_capacity": schema.Float64Attribute{
+				Computed: true,
+			},
+			"total_target_capacity": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"instance_type_specification": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[reservationFleetInstanceSpecificationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAvailabilityZone: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName(names.AttrAvailabilityZone),
+									path.MatchRelative().AtParent().AtName("availability_zone_id"),
+								),
+							},
+						},
+						"availability_zone_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"ebs_optimized": schema.BoolAttribute{
+							Optional: true,
+						},
+						"instance_platform": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationInstancePlatform](),
+							Required:   true,
+						},
+						names.AttrInstanceType: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrPriority: schema.Int64Attribute{
+							Optional: true,
+						},
+						names.AttrWeight: schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *capacityReservationFleetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data capacityReservationFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateCapacityReservationFleetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.TagSpecifications = getTagSpecificationsInV2(ctx, awstypes.ResourceTypeCapacityReservationFleet)
+
+	output, err := conn.CreateCapacityReservationFleet(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EC2 Capacity Reservation Fleet", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.CapacityReservationFleetId)
+
+	fleet, err := waitCapacityReservationFleetActive(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Capacity Reservation Fleet (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, fleet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *capacityReservationFleetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data capacityReservationFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findCapacityReservationFleetByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Capacity Reservation Fleet (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOutV2(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *capacityReservationFleetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new capacityReservationFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.EndDate.Equal(old.EndDate) || !new.TotalTargetCapacity.Equal(old.TotalTargetCapacity) {
+		input := &ec2.ModifyCapacityReservationFleetInput{
+			CapacityReservationFleetId: aws.String(new.ID.ValueString()),
+		}
+
+		if !new.EndDate.Equal(old.EndDate) {
+			if new.EndDate.IsNull() {
+				input.RemoveEndDate = aws.Bool(true)
+			} else {
+				endDate, d := new.EndDate.ValueRFC3339Time()
+				response.Diagnostics.Append(d...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				input.EndDate = aws.Time(endDate)
+			}
+		}
+
+		if !new.TotalTargetCapacity.Equal(old.TotalTargetCapacity) {
+			input.TotalTargetCapacity = fwflex.Int32FromFramework(ctx, new.TotalTargetCapacity)
+		}
+
+		_, err := conn.ModifyCapacityReservationFleet(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Capacity Reservation Fleet (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	fleet, err := waitCapacityReservationFleetActive(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Capacity Reservation Fleet (%s) update", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, fleet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *capacityReservationFleetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data capacityReservationFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := conn.CancelCapacityReservationFleets(ctx, &ec2.CancelCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: []string{data.ID.ValueString()},
+	})
+
+	if err == nil && output != nil {
+		err = cancelCapacityReservationFleetsError(output.FailedFleetCancellations)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Capacity Reservation Fleet (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitCapacityReservationFleetDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Capacity Reservation Fleet (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *capacityReservationFleetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type capacityReservationFleetResourceModel struct {
+	AllocationStrategy         types.String                                                                `tfsdk:"allocation_strategy"`
+	ARN                        types.String                                                                `tfsdk:"arn"`
+	CapacityReservationIDs     fwtypes.ListValueOf[types.String]                                           `tfsdk:"capacity_reservation_ids"`
+	CreateTime                 timetypes.RFC3339                                                           `tfsdk:"create_time"`
+	EndDate                    timetypes.RFC3339                                                           `tfsdk:"end_date"`
+	ID                         types.String                                                                `tfsdk:"id"`
+	InstanceMatchCriteria      fwtypes.StringEnum[awstypes.FleetInstanceMatchCriteria]                     `tfsdk:"instance_match_criteria"`
+	InstanceTypeSpecifications fwtypes.ListNestedObjectValueOf[reservationFleetInstanceSpecificationModel] `tfsdk:"instance_type_specification"`
+	State                      fwtypes.StringEnum[awstypes.CapacityReservationFleetState]                  `tfsdk:"state"`
+	Tags                       types.Map                                                                   `tfsdk:"tags"`
+	TagsAll                    types.Map                                                                   `tfsdk:"tags_all"`
+	Tenancy                    fwtypes.StringEnum[awstypes.FleetCapacityReservationTenancy]                `tfsdk:"tenancy"`
+	Timeouts                   timeouts.Value                                                              `tfsdk:"timeouts"`
+	TotalFulfilledCapacity     types.Float64                                                               `tfsdk:"total_fulfilled_capacity"`
+	TotalTargetCapacity        types.Int64                                                                 `tfsdk:"total_target_capacity"`
+}
+
+func (data *capacityReservationFleetResourceModel) flatten(ctx context.Context, apiObject *awstypes.CapacityReservationFleet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API reports the fleet's individual Capacity Reservations rather than the configured
+	// instance type specifications, so the specifications are only read back on import.
+	instanceTypeSpecifications := data.InstanceTypeSpecifications
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.CapacityReservationFleetArn)
+	data.CapacityReservationIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(apiObject.InstanceTypeSpecifications, func(v awstypes.FleetCapacityReservation) string {
+		return aws.ToString(v.CapacityReservationId)
+	}))
+	data.ID = fwflex.StringToFramework(ctx, apiObject.CapacityReservationFleetId)
+
+	if !instanceTypeSpecifications.IsNull() {
+		data.InstanceTypeSpecifications = instanceTypeSpecifications
+
+		return diags
+	}
+
+	specifications, d := data.InstanceTypeSpecifications.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, v := range specifications {
+		// Only one of the Availability Zone name or ID can be configured.
+		if !v.AvailabilityZone.IsNull() {
+			v.AvailabilityZoneID = types.StringNull()
+		}
+	}
+
+	data.InstanceTypeSpecifications = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, specifications)
+
+	return diags
+}
+
+type reservationFleetInstanceSpecificationModel struct {
+	AvailabilityZone   types.String                                                     `tfsdk:"availability_zone"`
+	AvailabilityZoneID types.String                                                     `tfsdk:"availability_zone_id"`
+	EbsOptimized       types.Bool                                                       `tfsdk:"ebs_optimized"`
+	InstancePlatform   fwtypes.StringEnum[awstypes.CapacityReservationInstancePlatform] `tfsdk:"instance_platform"`
+	InstanceType       types.String                                                     `tfsdk:"instance_type"`
+	Priority           types.Int64                                                      `tfsdk:"priority"`
+	Weight             types.Float64                                                    `tfsdk:"weight"`
+}
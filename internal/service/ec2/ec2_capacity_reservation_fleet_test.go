@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CapacityReservationFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "prioritized"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`capacity-reservation-fleet/crf-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_reservation_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type_specification.0.availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.CapacityReservationFleetStateActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The instance type specifications are rebuilt from the fleet's Capacity Reservations on import.
+				ImportStateVerifyIgnore: []string{"instance_type_specification"},
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceCapacityReservationFleet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The instance type specifications are rebuilt from the fleet's Capacity Reservations on import.
+				ImportStateVerifyIgnore: []string{"instance_type_specification"},
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	endDate := time.Now().UTC().Add(12 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_endDate(2, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v2),
+					testAccCheckCapacityReservationFleetNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v2),
+					testAccCheckCapacityReservationFleetNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationFleetExists(ctx context.Context, n string, v *awstypes.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindCapacityReservationFleetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_capacity_reservation_fleet" {
+				continue
+			}
+
+			_, err := tfec2.FindCapacityReservationFleetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Capacity Reservation Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationFleetNotRecreated(before, after *awstypes.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.CapacityReservationFleetId), aws.ToString(after.CapacityReservationFleetId); before != after {
+			return fmt.Errorf("EC2 Capacity Reservation Fleet (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapacityReservationFleetConfig_base() string {
+	return acctest.ConfigAvailableAZsNoOptIn()
+}
+
+func testAccCapacityReservationFleetConfig_basic(totalTargetCapacity int) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_base(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = %[1]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t2.micro"
+    priority          = 1
+    weight            = 1
+  }
+}
+`, totalTargetCapacity))
+}
+
+func testAccCapacityReservationFleetConfig_endDate(totalTargetCapacity int, endDate string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_base(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  end_date              = %[2]q
+  total_target_capacity = %[1]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t2.micro"
+    priority          = 1
+    weight            = 1
+  }
+}
+`, totalTargetCapacity, endDate))
+}
+
+func testAccCapacityReservationFleetConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_base(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t2.micro"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccCapacityReservationFleetConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_base(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t2.micro"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -51,6 +51,11 @@ func testAccInstanceMetadataDefaults_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccInstanceMetadataDefaultsConfig_partial,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -73,7 +73,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 			"context": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			// Provided constants do not have the correct casing so going with hard-coded values.
@@ -566,6 +565,12 @@ func resourceSpotFleetRequest() *schema.Resource {
 														ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
 													},
 												},
+												"max_spot_price_as_percentage_of_optimal_on_demand_price": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
 												"memory_gib_per_vcpu": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -803,6 +808,12 @@ func resourceSpotFleetRequest() *schema.Resource {
 										ForceNew:         true,
 										ValidateDiagFunc: enum.Validate[awstypes.ReplacementStrategy](),
 									},
+									"termination_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(120, 7200),
+									},
 								},
 							},
 						},
@@ -1127,6 +1138,10 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 			SpotFleetRequestId: aws.String(d.Id()),
 		}
 
+		if d.HasChange("context") {
+			input.Context = aws.String(d.Get("context").(string))
+		}
+
 		if d.HasChange("target_capacity") {
 			input.TargetCapacity = aws.Int32(int32(d.Get("target_capacity").(int)))
 		}
@@ -1640,6 +1655,10 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.LocalStorageTypes = flex.ExpandStringyValueSet[awstypes.LocalStorageType](v)
 	}
 
+	if v, ok := tfMap["max_spot_price_as_percentage_of_optimal_on_demand_price"].(int); ok && v != 0 {
+		apiObject.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice = aws.Int32(int32(v))
+	}
+
 	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 {
 		apiObject.MemoryGiBPerVCpu = expandMemoryGiBPerVCPU(v[0].(map[string]interface{}))
 	}
@@ -1648,6 +1667,10 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.MemoryMiB = expandMemoryMiB(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NetworkBandwidthGbps = expandNetworkBandwidthGbps(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 {
 		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCount(v[0].(map[string]interface{}))
 	}
@@ -1765,6 +1788,24 @@ func expandMemoryMiB(tfMap map[string]interface{}) *awstypes.MemoryMiB {
 	return apiObject
 }
 
+func expandNetworkBandwidthGbps(tfMap map[string]interface{}) *awstypes.NetworkBandwidthGbps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkBandwidthGbps{}
+
+	if v, ok := tfMap[names.AttrMax].(float64); ok {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	if v, ok := tfMap[names.AttrMin].(float64); ok {
+		apiObject.Min = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
 func expandNetworkInterfaceCount(tfMap map[string]interface{}) *awstypes.NetworkInterfaceCount {
 	if tfMap == nil {
 		return nil
@@ -1846,6 +1887,10 @@ func expandSpotCapacityRebalance(l []interface{}) *awstypes.SpotCapacityRebalanc
 		capacityRebalance.ReplacementStrategy = awstypes.ReplacementStrategy(v.(string))
 	}
 
+	if v, ok := m["termination_delay"].(int); ok && v != 0 {
+		capacityRebalance.TerminationDelay = aws.Int32(int32(v))
+	}
+
 	return capacityRebalance
 }
 
@@ -2221,5 +2266,9 @@ func flattenSpotCapacityRebalance(spotCapacityRebalance *awstypes.SpotCapacityRe
 		"replacement_strategy": spotCapacityRebalance.ReplacementStrategy,
 	}
 
+	if v := spotCapacityRebalance.TerminationDelay; v != nil {
+		m["termination_delay"] = aws.ToInt32(v)
+	}
+
 	return []interface{}{m}
 }
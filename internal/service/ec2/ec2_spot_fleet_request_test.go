@@ -405,15 +405,18 @@ func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(
 						"overrides.#": acctest.Ct1,
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
-						"instance_requirements.#":                        acctest.Ct1,
-						"instance_requirements.0.instance_generations.#": acctest.Ct1,
-						"instance_requirements.0.memory_mib.#":           acctest.Ct1,
-						"instance_requirements.0.memory_mib.0.max":       "50000",
-						"instance_requirements.0.memory_mib.0.min":       "500",
-						"instance_requirements.0.vcpu_count.#":           acctest.Ct1,
-						"instance_requirements.0.vcpu_count.0.max":       "8",
-						"instance_requirements.0.vcpu_count.0.min":       acctest.Ct1,
-						names.AttrInstanceType:                           "",
+						"instance_requirements.#":                                                         acctest.Ct1,
+						"instance_requirements.0.instance_generations.#":                                  acctest.Ct1,
+						"instance_requirements.0.max_spot_price_as_percentage_of_optimal_on_demand_price": "75",
+						"instance_requirements.0.memory_mib.#":                                            acctest.Ct1,
+						"instance_requirements.0.memory_mib.0.max":                                        "50000",
+						"instance_requirements.0.memory_mib.0.min":                                        "500",
+						"instance_requirements.0.network_bandwidth_gbps.#":                                acctest.Ct1,
+						"instance_requirements.0.network_bandwidth_gbps.0.min":                            acctest.Ct1,
+						"instance_requirements.0.vcpu_count.#":                                            acctest.Ct1,
+						"instance_requirements.0.vcpu_count.0.max":                                        "8",
+						"instance_requirements.0.vcpu_count.0.min":                                        acctest.Ct1,
+						names.AttrInstanceType:                                                            "",
 					}),
 				),
 			},
@@ -1702,6 +1705,42 @@ func TestAccEC2SpotFleetRequest_capacityRebalance(t *testing.T) {
 	})
 }
 
+func TestAccEC2SpotFleetRequest_capacityRebalanceLaunchBeforeTerminate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_capacityRebalanceLaunchBeforeTerminate(rName, publicKey, validUntil, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", "launch-before-terminate"),
+					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.termination_delay", "120"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_instanceStoreAMI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config awstypes.SpotFleetRequestConfig
@@ -2369,7 +2408,12 @@ resource "aws_spot_fleet_request" "test" {
           max = 50000
         }
 
-        instance_generations = ["current"]
+        network_bandwidth_gbps {
+          min = 1
+        }
+
+        instance_generations                                    = ["current"]
+        max_spot_price_as_percentage_of_optimal_on_demand_price = 75
       }
     }
   }
@@ -3403,6 +3447,38 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_capacityRebalanceLaunchBeforeTerminate(rName, publicKey, validUntil string, terminationDelay int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  spot_maintenance_strategies {
+    capacity_rebalance {
+      replacement_strategy = "launch-before-terminate"
+      termination_delay    = %[3]d
+    }
+  }
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, terminationDelay))
+}
+
 func testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil string, targetCapacity int) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
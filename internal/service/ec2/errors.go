@@ -33,6 +33,7 @@ const (
 	errCodeInvalidAssociationIDNotFound                            = "InvalidAssociationID.NotFound"
 	errCodeInvalidAssociationNotFound                              = "InvalidAssociation.NotFound"
 	errCodeInvalidAttachmentIDNotFound                             = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound               = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound                    = "InvalidCapacityReservationId.NotFound"
 	errCodeInvalidCarrierGatewayIDNotFound                         = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound               = "InvalidClientVpnActiveAssociationNotFound"
@@ -128,6 +129,7 @@ const (
 	errCodeNetworkACLEntryAlreadyExists                            = "NetworkAclEntryAlreadyExists"
 	errCodeOperationNotPermitted                                   = "OperationNotPermitted"
 	errCodePrefixListVersionMismatch                               = "PrefixListVersionMismatch"
+	errCodeRequestLimitExceeded                                    = "RequestLimitExceeded"
 	errCodeResourceNotReady                                        = "ResourceNotReady"
 	errCodeRouteAlreadyExists                                      = "RouteAlreadyExists"
 	errCodeSnapshotCreationPerVolumeRateExceeded                   = "SnapshotCreationPerVolumeRateExceeded"
@@ -263,3 +265,23 @@ func networkACLEntryAlreadyExistsError(naclID string, egress bool, ruleNumber in
 func routeAlreadyExistsError(routeTableID, destination string) error {
 	return errs.APIError(errCodeRouteAlreadyExists, fmt.Sprintf("Route in Route Table (%s) with destination (%s) already exists", routeTableID, destination))
 }
+
+func cancelCapacityReservationFleetError(apiObject *awstypes.CancelCapacityReservationFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return errs.APIError(aws_sdkv2.ToString(apiObject.Code), aws_sdkv2.ToString(apiObject.Message))
+}
+
+func cancelCapacityReservationFleetsError(apiObjects []awstypes.FailedCapacityReservationFleetCancellationResult) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if err := cancelCapacityReservationFleetError(apiObject.CancelCapacityReservationFleetError); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", aws_sdkv2.ToString(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -5,96 +5,103 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceAMICopy                                  = resourceAMICopy
-	ResourceAMIFromInstance                          = resourceAMIFromInstance
-	ResourceAMILaunchPermission                      = resourceAMILaunchPermission
-	ResourceAvailabilityZoneGroup                    = resourceAvailabilityZoneGroup
-	ResourceCapacityReservation                      = resourceCapacityReservation
-	ResourceCarrierGateway                           = resourceCarrierGateway
-	ResourceClientVPNAuthorizationRule               = resourceClientVPNAuthorizationRule
-	ResourceClientVPNEndpoint                        = resourceClientVPNEndpoint
-	ResourceClientVPNNetworkAssociation              = resourceClientVPNNetworkAssociation
-	ResourceClientVPNRoute                           = resourceClientVPNRoute
-	ResourceCustomerGateway                          = resourceCustomerGateway
-	ResourceDefaultNetworkACL                        = resourceDefaultNetworkACL
-	ResourceDefaultRouteTable                        = resourceDefaultRouteTable
-	ResourceEBSDefaultKMSKey                         = resourceEBSDefaultKMSKey
-	ResourceEBSEncryptionByDefault                   = resourceEBSEncryptionByDefault
-	ResourceEBSFastSnapshotRestore                   = newEBSFastSnapshotRestoreResource
-	ResourceEBSSnapshot                              = resourceEBSSnapshot
-	ResourceEBSSnapshotCopy                          = resourceEBSSnapshotCopy
-	ResourceEBSSnapshotImport                        = resourceEBSSnapshotImport
-	ResourceEBSVolume                                = resourceEBSVolume
-	ResourceEIP                                      = resourceEIP
-	ResourceEIPAssociation                           = resourceEIPAssociation
-	ResourceEIPDomainName                            = newEIPDomainNameResource
-	ResourceFleet                                    = resourceFleet
-	ResourceHost                                     = resourceHost
-	ResourceIPAM                                     = resourceIPAM
-	ResourceIPAMOrganizationAdminAccount             = resourceIPAMOrganizationAdminAccount
-	ResourceIPAMPool                                 = resourceIPAMPool
-	ResourceIPAMPoolCIDR                             = resourceIPAMPoolCIDR
-	ResourceIPAMPoolCIDRAllocation                   = resourceIPAMPoolCIDRAllocation
-	ResourceIPAMPreviewNextCIDR                      = resourceIPAMPreviewNextCIDR
-	ResourceIPAMResourceDiscovery                    = resourceIPAMResourceDiscovery
-	ResourceIPAMResourceDiscoveryAssociation         = resourceIPAMResourceDiscoveryAssociation
-	ResourceIPAMScope                                = resourceIPAMScope
-	ResourceImageBlockPublicAccess                   = resourceImageBlockPublicAccess
-	ResourceInstance                                 = resourceInstance
-	ResourceInstanceConnectEndpoint                  = newInstanceConnectEndpointResource
-	ResourceInstanceMetadataDefaults                 = newInstanceMetadataDefaultsResource
-	ResourceInstanceState                            = resourceInstanceState
-	ResourceKeyPair                                  = resourceKeyPair
-	ResourceLaunchTemplate                           = resourceLaunchTemplate
-	ResourceMainRouteTableAssociation                = resourceMainRouteTableAssociation
-	ResourceNetworkACL                               = resourceNetworkACL
-	ResourceNetworkACLRule                           = resourceNetworkACLRule
-	ResourceNetworkInsightsAnalysis                  = resourceNetworkInsightsAnalysis
-	ResourceNetworkInsightsPath                      = resourceNetworkInsightsPath
-	ResourceNetworkInterface                         = resourceNetworkInterface
-	ResourcePlacementGroup                           = resourcePlacementGroup
-	ResourceRoute                                    = resourceRoute
-	ResourceRouteTable                               = resourceRouteTable
-	ResourceSecurityGroupEgressRule                  = newSecurityGroupEgressRuleResource
-	ResourceSecurityGroupIngressRule                 = newSecurityGroupIngressRuleResource
-	ResourceSnapshotCreateVolumePermission           = resourceSnapshotCreateVolumePermission
-	ResourceSpotDataFeedSubscription                 = resourceSpotDataFeedSubscription
-	ResourceSpotFleetRequest                         = resourceSpotFleetRequest
-	ResourceSpotInstanceRequest                      = resourceSpotInstanceRequest
-	ResourceTag                                      = resourceTag
-	ResourceTrafficMirrorFilter                      = resourceTrafficMirrorFilter
-	ResourceTrafficMirrorFilterRule                  = resourceTrafficMirrorFilterRule
-	ResourceTrafficMirrorSession                     = resourceTrafficMirrorSession
-	ResourceTrafficMirrorTarget                      = resourceTrafficMirrorTarget
-	ResourceTransitGatewayConnect                    = resourceTransitGatewayConnect
-	ResourceTransitGatewayMulticastDomain            = resourceTransitGatewayMulticastDomain
-	ResourceTransitGatewayMulticastDomainAssociation = resourceTransitGatewayMulticastDomainAssociation
-	ResourceTransitGatewayMulticastGroupMember       = resourceTransitGatewayMulticastGroupMember
-	ResourceTransitGatewayMulticastGroupSource       = resourceTransitGatewayMulticastGroupSource
-	ResourceTransitGatewayPeeringAttachment          = resourceTransitGatewayPeeringAttachment
-	ResourceTransitGatewayPeeringAttachmentAccepter  = resourceTransitGatewayPeeringAttachmentAccepter
-	ResourceTransitGatewayPolicyTable                = resourceTransitGatewayPolicyTable
-	ResourceTransitGatewayPolicyTableAssociation     = resourceTransitGatewayPolicyTableAssociation
-	ResourceTransitGatewayPrefixListReference        = resourceTransitGatewayPrefixListReference
-	ResourceTransitGatewayRoute                      = resourceTransitGatewayRoute
-	ResourceTransitGatewayRouteTable                 = resourceTransitGatewayRouteTable
-	ResourceTransitGatewayRouteTableAssociation      = resourceTransitGatewayRouteTableAssociation
-	ResourceTransitGatewayRouteTablePropagation      = resourceTransitGatewayRouteTablePropagation
-	ResourceTransitGatewayVPCAttachment              = resourceTransitGatewayVPCAttachment
-	ResourceTransitGatewayVPCAttachmentAccepter      = resourceTransitGatewayVPCAttachmentAccepter
-	ResourceVPCEndpoint                              = resourceVPCEndpoint
-	ResourceVPNConnection                            = resourceVPNConnection
-	ResourceVPNConnectionRoute                       = resourceVPNConnectionRoute
-	ResourceVPNGateway                               = resourceVPNGateway
-	ResourceVPNGatewayAttachment                     = resourceVPNGatewayAttachment
-	ResourceVPNGatewayRoutePropagation               = resourceVPNGatewayRoutePropagation
-	ResourceVolumeAttachment                         = resourceVolumeAttachment
+	ResourceAMICopy                                    = resourceAMICopy
+	ResourceAMIFromInstance                            = resourceAMIFromInstance
+	ResourceAMILaunchPermission                        = resourceAMILaunchPermission
+	ResourceAvailabilityZoneGroup                      = resourceAvailabilityZoneGroup
+	ResourceCapacityReservation                        = resourceCapacityReservation
+	ResourceCapacityReservationFleet                   = newCapacityReservationFleetResource
+	ResourceCarrierGateway                             = resourceCarrierGateway
+	ResourceClientVPNAuthorizationRule                 = resourceClientVPNAuthorizationRule
+	ResourceClientVPNEndpoint                          = resourceClientVPNEndpoint
+	ResourceClientVPNNetworkAssociation                = resourceClientVPNNetworkAssociation
+	ResourceClientVPNRoute                             = resourceClientVPNRoute
+	ResourceCustomerGateway                            = resourceCustomerGateway
+	ResourceDefaultNetworkACL                          = resourceDefaultNetworkACL
+	ResourceDefaultRouteTable                          = resourceDefaultRouteTable
+	ResourceEBSDefaultKMSKey                           = resourceEBSDefaultKMSKey
+	ResourceEBSEncryptionByDefault                     = resourceEBSEncryptionByDefault
+	ResourceEBSFastSnapshotRestore                     = newEBSFastSnapshotRestoreResource
+	ResourceEBSSnapshot                                = resourceEBSSnapshot
+	ResourceEBSSnapshotCopy                            = resourceEBSSnapshotCopy
+	ResourceEBSSnapshotBlockPublicAccess               = resourceSnapshotBlockPublicAccess
+	ResourceEBSSnapshotImport                          = resourceEBSSnapshotImport
+	ResourceEBSSnapshotLock                            = newEBSSnapshotLockResource
+	ResourceEBSVolume                                  = resourceEBSVolume
+	ResourceEIP                                        = resourceEIP
+	ResourceEIPAssociation                             = resourceEIPAssociation
+	ResourceEIPDomainName                              = newEIPDomainNameResource
+	ResourceFleet                                      = resourceFleet
+	ResourceHost                                       = resourceHost
+	ResourceIPAM                                       = resourceIPAM
+	ResourceIPAMOrganizationAdminAccount               = resourceIPAMOrganizationAdminAccount
+	ResourceIPAMPool                                   = resourceIPAMPool
+	ResourceIPAMPoolCIDR                               = resourceIPAMPoolCIDR
+	ResourceIPAMPoolCIDRAllocation                     = resourceIPAMPoolCIDRAllocation
+	ResourceIPAMPreviewNextCIDR                        = resourceIPAMPreviewNextCIDR
+	ResourceIPAMResourceDiscovery                      = resourceIPAMResourceDiscovery
+	ResourceIPAMResourceDiscoveryAssociation           = resourceIPAMResourceDiscoveryAssociation
+	ResourceIPAMScope                                  = resourceIPAMScope
+	ResourceImageBlockPublicAccess                     = resourceImageBlockPublicAccess
+	ResourceInstance                                   = resourceInstance
+	ResourceInstanceConnectEndpoint                    = newInstanceConnectEndpointResource
+	ResourceInstanceMetadataDefaults                   = newInstanceMetadataDefaultsResource
+	ResourceInstanceState                              = resourceInstanceState
+	ResourceKeyPair                                    = resourceKeyPair
+	ResourceLaunchTemplate                             = resourceLaunchTemplate
+	ResourceMainRouteTableAssociation                  = resourceMainRouteTableAssociation
+	ResourceNetworkACL                                 = resourceNetworkACL
+	ResourceNetworkACLRule                             = resourceNetworkACLRule
+	ResourceNetworkInsightsAnalysis                    = resourceNetworkInsightsAnalysis
+	ResourceNetworkInsightsPath                        = resourceNetworkInsightsPath
+	ResourceNetworkInterface                           = resourceNetworkInterface
+	ResourcePlacementGroup                             = resourcePlacementGroup
+	ResourceRoute                                      = resourceRoute
+	ResourceRouteTable                                 = resourceRouteTable
+	ResourceRouteTableRoutes                           = resourceRouteTableRoutes
+	ResourceSecurityGroupEgressRule                    = newSecurityGroupEgressRuleResource
+	ResourceSecurityGroupIngressRule                   = newSecurityGroupIngressRuleResource
+	ResourceSnapshotCreateVolumePermission             = resourceSnapshotCreateVolumePermission
+	ResourceSpotDataFeedSubscription                   = resourceSpotDataFeedSubscription
+	ResourceSpotFleetRequest                           = resourceSpotFleetRequest
+	ResourceSpotInstanceRequest                        = resourceSpotInstanceRequest
+	ResourceTag                                        = resourceTag
+	ResourceTrafficMirrorFilter                        = resourceTrafficMirrorFilter
+	ResourceTrafficMirrorFilterRule                    = resourceTrafficMirrorFilterRule
+	ResourceTrafficMirrorSession                       = resourceTrafficMirrorSession
+	ResourceTrafficMirrorTarget                        = resourceTrafficMirrorTarget
+	ResourceTransitGatewayConnect                      = resourceTransitGatewayConnect
+	ResourceTransitGatewayDefaultRouteTableAssociation = resourceTransitGatewayDefaultRouteTableAssociation
+	ResourceTransitGatewayDefaultRouteTablePropagation = resourceTransitGatewayDefaultRouteTablePropagation
+	ResourceTransitGatewayMulticastDomain              = resourceTransitGatewayMulticastDomain
+	ResourceTransitGatewayMulticastDomainAssociation   = resourceTransitGatewayMulticastDomainAssociation
+	ResourceTransitGatewayMulticastGroupMember         = resourceTransitGatewayMulticastGroupMember
+	ResourceTransitGatewayMulticastGroupSource         = resourceTransitGatewayMulticastGroupSource
+	ResourceTransitGatewayPeeringAttachment            = resourceTransitGatewayPeeringAttachment
+	ResourceTransitGatewayPeeringAttachmentAccepter    = resourceTransitGatewayPeeringAttachmentAccepter
+	ResourceTransitGatewayPolicyTable                  = resourceTransitGatewayPolicyTable
+	ResourceTransitGatewayPolicyTableAssociation       = resourceTransitGatewayPolicyTableAssociation
+	ResourceTransitGatewayPrefixListReference          = resourceTransitGatewayPrefixListReference
+	ResourceTransitGatewayRoute                        = resourceTransitGatewayRoute
+	ResourceTransitGatewayRouteTable                   = resourceTransitGatewayRouteTable
+	ResourceTransitGatewayRouteTableAssociation        = resourceTransitGatewayRouteTableAssociation
+	ResourceTransitGatewayRouteTablePropagation        = resourceTransitGatewayRouteTablePropagation
+	ResourceTransitGatewayVPCAttachment                = resourceTransitGatewayVPCAttachment
+	ResourceTransitGatewayVPCAttachmentAccepter        = resourceTransitGatewayVPCAttachmentAccepter
+	ResourceVPCEndpoint                                = resourceVPCEndpoint
+	ResourceVPNConnection                              = resourceVPNConnection
+	ResourceVPNConnectionRoute                         = resourceVPNConnectionRoute
+	ResourceVPNGateway                                 = resourceVPNGateway
+	ResourceVPNGatewayAttachment                       = resourceVPNGatewayAttachment
+	ResourceVPNGatewayRoutePropagation                 = resourceVPNGatewayRoutePropagation
+	ResourceVolumeAttachment                           = resourceVolumeAttachment
 
 	CustomFiltersSchema                                        = customFiltersSchema
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
+	FindCapacityReservationFleetByID                           = findCapacityReservationFleetByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
 	FindClientVPNAuthorizationRuleByThreePartKey               = findClientVPNAuthorizationRuleByThreePartKey
 	FindClientVPNEndpointByID                                  = findClientVPNEndpointByID
@@ -123,6 +130,7 @@ var (
 	FindInstanceStateByID                                      = findInstanceStateByID
 	FindKeyPairByName                                          = findKeyPairByName
 	FindLaunchTemplateByID                                     = findLaunchTemplateByID
+	FindLockedSnapshotByID                                     = findLockedSnapshotByID
 	FindMainRouteTableAssociationByID                          = findMainRouteTableAssociationByID
 	FindNetworkACLByIDV2                                       = findNetworkACLByID
 	FindNetworkInsightsAnalysisByID                            = findNetworkInsightsAnalysisByID
@@ -137,6 +145,7 @@ var (
 	FindRouteTableAssociationByID                              = findRouteTableAssociationByID
 	FindRouteTableByID                                         = findRouteTableByID
 	FindSnapshot                                               = findSnapshot
+	FindSnapshotBlockPublicAccessState                         = findSnapshotBlockPublicAccessState
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
 	FindSpotFleetRequestByID                                   = findSpotFleetRequestByID
@@ -74,6 +74,68 @@ func findAvailabilityZoneGroupByName(ctx context.Context, conn *ec2.Client, name
 	return &availabilityZone, nil
 }
 
+func findCapacityReservationFleet(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationFleetsInput) (*awstypes.CapacityReservationFleet, error) {
+	output, err := findCapacityReservationFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCapacityReservationFleets(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationFleetsInput) ([]awstypes.CapacityReservationFleet, error) {
+	var output []awstypes.CapacityReservationFleet
+
+	pages := ec2.NewDescribeCapacityReservationFleetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityReservationFleets...)
+	}
+
+	return output, nil
+}
+
+func findCapacityReservationFleetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: []string{id},
+	}
+
+	output, err := findCapacityReservationFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.CapacityReservationFleetStateCancelled || state == awstypes.CapacityReservationFleetStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.CapacityReservationFleetId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findCapacityReservation(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) (*awstypes.CapacityReservation, error) {
 	output, err := findCapacityReservations(ctx, conn, input)
 
@@ -3157,6 +3219,21 @@ func findImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (*st
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func findSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (awstypes.SnapshotBlockPublicAccessState, error) {
+	input := &ec2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.State, nil
+}
+
 func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.LaunchPermission, error) {
 	input := &ec2.DescribeImageAttributeInput{
 		Attribute: awstypes.ImageAttributeNameLaunchPermission,
@@ -4505,6 +4582,73 @@ func findSnapshotByID(ctx context.Context, conn *ec2.Client, id string) (*awstyp
 	return output, nil
 }
 
+func findLockedSnapshots(ctx context.Context, conn *ec2.Client, input *ec2.DescribeLockedSnapshotsInput) ([]awstypes.LockedSnapshotsInfo, error) {
+	var output []awstypes.LockedSnapshotsInfo
+
+	for {
+		page, err := conn.DescribeLockedSnapshots(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Snapshots...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findLockedSnapshot(ctx context.Context, conn *ec2.Client, input *ec2.DescribeLockedSnapshotsInput) (*awstypes.LockedSnapshotsInfo, error) {
+	output, err := findLockedSnapshots(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLockedSnapshotByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.LockedSnapshotsInfo, error) {
+	input := &ec2.DescribeLockedSnapshotsInput{
+		SnapshotIds: []string{id},
+	}
+
+	output, err := findLockedSnapshot(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.LockState; state == awstypes.LockStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.SnapshotId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findSnapshotAttribute(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSnapshotAttributeInput) (*ec2.DescribeSnapshotAttributeOutput, error) {
 	output, err := conn.DescribeSnapshotAttribute(ctx, input)
 
@@ -31,10 +31,21 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newCapacityReservationFleetResource,
+			Name:    "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory: newEBSFastSnapshotRestoreResource,
 			Name:    "EBS Fast Snapshot Restore",
 		},
+		{
+			Factory: newEBSSnapshotLockResource,
+			Name:    "EBS Snapshot Lock",
+		},
 		{
 			Factory: newEIPDomainNameResource,
 			Name:    "EIP Domain Name",
@@ -485,6 +496,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCs,
 			TypeName: "aws_vpcs",
 		},
+		{
+			Factory:  dataSourceVPNConnectionDeviceSampleConfiguration,
+			TypeName: "aws_vpn_connection_device_sample_configuration",
+			Name:     "VPN Connection Device Sample Configuration",
+		},
 		{
 			Factory:  dataSourceVPNGateway,
 			TypeName: "aws_vpn_gateway",
@@ -599,6 +615,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "Snapshot Block Public Access",
+		},
 		{
 			Factory:  resourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -800,6 +821,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+			Name:     "Transit Gateway Default Route Table Association",
+		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+			Name:     "Transit Gateway Default Route Table Propagation",
+		},
 		{
 			Factory:  resourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -1032,6 +1063,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRouteTableAssociation,
 			TypeName: "aws_route_table_association",
 		},
+		{
+			Factory:  resourceRouteTableRoutes,
+			TypeName: "aws_route_table_routes",
+			Name:     "Route Table Routes",
+		},
 		{
 			Factory:  ResourceSecurityGroup,
 			TypeName: "aws_security_group",
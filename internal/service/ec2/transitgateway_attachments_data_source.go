@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,6 +28,50 @@ func dataSourceTransitGatewayAttachments() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_transit_gateway_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTransitGatewayAttachmentID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTransitGatewayID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrFilter: customFiltersSchema(),
 			names.AttrIDs: {
 				Type:     schema.TypeList,
@@ -61,13 +106,37 @@ func dataSourceTransitGatewayAttachmentsRead(ctx context.Context, d *schema.Reso
 	}
 
 	var attachmentIDs []string
+	var tfList []interface{}
 
 	for _, v := range transitGatewayAttachments {
 		attachmentIDs = append(attachmentIDs, aws.ToString(v.TransitGatewayAttachmentId))
+		tfList = append(tfList, flattenTransitGatewayAttachment(v))
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("attachments", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments: %s", err)
+	}
 	d.Set(names.AttrIDs, attachmentIDs)
 
 	return diags
 }
+
+func flattenTransitGatewayAttachment(apiObject awstypes.TransitGatewayAttachment) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrResourceID:                 aws.ToString(apiObject.ResourceId),
+		"resource_owner_id":                  aws.ToString(apiObject.ResourceOwnerId),
+		names.AttrResourceType:               string(apiObject.ResourceType),
+		names.AttrState:                      string(apiObject.State),
+		names.AttrTransitGatewayAttachmentID: aws.ToString(apiObject.TransitGatewayAttachmentId),
+		names.AttrTransitGatewayID:           aws.ToString(apiObject.TransitGatewayId),
+		"transit_gateway_owner_id":           aws.ToString(apiObject.TransitGatewayOwnerId),
+	}
+
+	if v := apiObject.Association; v != nil {
+		tfMap["association_state"] = string(v.State)
+		tfMap["association_transit_gateway_route_table_id"] = aws.ToString(v.TransitGatewayRouteTableId)
+	}
+
+	return tfMap
+}
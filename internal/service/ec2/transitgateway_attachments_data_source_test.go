@@ -32,6 +32,14 @@ func testAccTransitGatewayAttachmentsDataSource_Filter(t *testing.T, semaphore t
 				Config: testAccTransitGatewayAttachmentsDataSourceConfig_filter(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.association_state", "associated"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.association_transit_gateway_route_table_id", "aws_ec2_transit_gateway.test", "association_default_route_table_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.resource_id", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.resource_type", "vpc"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.state", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.transit_gateway_id", "aws_ec2_transit_gateway.test", names.AttrID),
 				),
 			},
 		},
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_propagation", name="Transit Gateway Default Route Table Propagation")
+func resourceTransitGatewayDefaultRouteTablePropagation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayDefaultRouteTablePropagationCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayDefaultRouteTablePropagationRead,
+		UpdateWithoutTimeout: resourceTransitGatewayDefaultRouteTablePropagationUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayDefaultRouteTablePropagationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTransitGatewayID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayDefaultRouteTablePropagationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	transitGatewayID := d.Get(names.AttrTransitGatewayID).(string)
+	transitGateway, err := findTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	if transitGateway.Options.DefaultRouteTablePropagation == awstypes.DefaultRouteTablePropagationValueDisable {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Default Route Table Propagation (%s): default route table propagation is disabled on the transit gateway", transitGatewayID)
+	}
+
+	if err := modifyTransitGatewayPropagationDefaultRouteTable(ctx, conn, transitGatewayID, d.Get("transit_gateway_route_table_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Default Route Table Propagation (%s): %s", transitGatewayID, err)
+	}
+
+	d.SetId(transitGatewayID)
+	d.Set("original_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
+
+	return append(diags, resourceTransitGatewayDefaultRouteTablePropagationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTablePropagationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	transitGateway, err := findTransitGatewayByID(ctx, conn, d.Id())
+
+	if err == nil && transitGateway.Options.DefaultRouteTablePropagation == awstypes.DefaultRouteTablePropagationValueDisable {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Default Route Table Propagation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Default Route Table Propagation (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrTransitGatewayID, transitGateway.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTablePropagationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if err := modifyTransitGatewayPropagationDefaultRouteTable(ctx, conn, d.Id(), d.Get("transit_gateway_route_table_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Default Route Table Propagation (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTablePropagationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTablePropagationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// There is no route table to restore if the transit gateway had no default propagation route table.
+	originalRouteTableID := d.Get("original_default_route_table_id").(string)
+	if originalRouteTableID == "" {
+		log.Printf("[DEBUG] Removing EC2 Transit Gateway Default Route Table Propagation (%s) from state, no original default route table to restore", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Default Route Table Propagation: %s", d.Id())
+	err := modifyTransitGatewayPropagationDefaultRouteTable(ctx, conn, d.Id(), originalRouteTableID, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Default Route Table Propagation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func modifyTransitGatewayPropagationDefaultRouteTable(ctx context.Context, conn *ec2.Client, transitGatewayID, transitGatewayRouteTableID string, timeout time.Duration) error {
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &awstypes.ModifyTransitGatewayOptions{
+			PropagationDefaultRouteTableId: aws.String(transitGatewayRouteTableID),
+		},
+		TransitGatewayId: aws.String(transitGatewayID),
+	}
+
+	if _, err := conn.ModifyTransitGateway(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitTransitGatewayUpdated(ctx, conn, transitGatewayID, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
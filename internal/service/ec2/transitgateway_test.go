@@ -46,6 +46,16 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"InsideCidrBlocks":      testAccTransitGatewayConnectPeer_insideCIDRBlocks,
 			"TransitGatewayAddress": testAccTransitGatewayConnectPeer_TransitGatewayAddress,
 		},
+		"DefaultRouteTableAssociation": {
+			acctest.CtBasic:      testAccTransitGatewayDefaultRouteTableAssociation_basic,
+			acctest.CtDisappears: testAccTransitGatewayDefaultRouteTableAssociation_disappears,
+			"disabled":           testAccTransitGatewayDefaultRouteTableAssociation_disabled,
+		},
+		"DefaultRouteTablePropagation": {
+			acctest.CtBasic:      testAccTransitGatewayDefaultRouteTablePropagation_basic,
+			acctest.CtDisappears: testAccTransitGatewayDefaultRouteTablePropagation_disappears,
+			"disabled":           testAccTransitGatewayDefaultRouteTablePropagation_disabled,
+		},
 		"Gateway": {
 			acctest.CtBasic:               testAccTransitGateway_basic,
 			acctest.CtDisappears:          testAccTransitGateway_disappears,
@@ -86,6 +96,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 		"PeeringAttachment": {
 			acctest.CtBasic:      testAccTransitGatewayPeeringAttachment_basic,
 			acctest.CtDisappears: testAccTransitGatewayPeeringAttachment_disappears,
+			"options":            testAccTransitGatewayPeeringAttachment_options,
 			"tags":               testAccTransitGatewayPeeringAttachment_tags,
 			"DifferentAccount":   testAccTransitGatewayPeeringAttachment_differentAccount,
 		},
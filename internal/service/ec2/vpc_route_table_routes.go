@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_route_table_routes", name="Route Table Routes")
+func resourceRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRouteTableRoutesCreate,
+		ReadWithoutTimeout:   resourceRouteTableRoutesRead,
+		UpdateWithoutTimeout: resourceRouteTableRoutesUpdate,
+		DeleteWithoutTimeout: resourceRouteTableRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem:       routeTableRouteResource(),
+				Set:        resourceRouteTableHash,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTableID := d.Get("route_table_id").(string)
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+
+	// Any existing routes not in configuration are removed.
+	o := schema.NewSet(resourceRouteTableHash, flattenRoutes(ctx, conn, d, routeTable.Routes))
+	n := d.Get("route").(*schema.Set)
+
+	if err := routeTableReconcileRoutes(ctx, conn, routeTableID, o, n, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route Table (%s) routes: %s", routeTableID, err)
+	}
+
+	return append(diags, resourceRouteTableRoutesRead(ctx, d, meta)...)
+}
+
+func resourceRouteTableRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routeTable, err := findRouteTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s) routes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("route", flattenRoutes(ctx, conn, d, routeTable.Routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("route_table_id", routeTable.RouteTableId)
+
+	return diags
+}
+
+func resourceRouteTableRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+
+		if err := routeTableReconcileRoutes(ctx, conn, d.Id(), o.(*schema.Set), n.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route Table (%s) routes: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRouteTableRoutesRead(ctx, d, meta)...)
+}
+
+func resourceRouteTableRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if _, err := findRouteTableByID(ctx, conn, d.Id()); tfresource.NotFound(err) {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Route Table (%s) routes", d.Id())
+	o := d.Get("route").(*schema.Set)
+	n := schema.NewSet(resourceRouteTableHash, nil)
+
+	if err := routeTableReconcileRoutes(ctx, conn, d.Id(), o, n, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route Table (%s) routes: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// routeTableReconcileRoutes makes the routes in the specified route table match the new set.
+// Routes whose destinations are only in the old set are deleted, routes whose targets have changed are replaced
+// and routes whose destinations are only in the new set are created.
+// All changes are attempted and any errors, one per failed route, are returned together.
+func routeTableReconcileRoutes(ctx context.Context, conn *ec2.Client, routeTableID string, o, n *schema.Set, timeout time.Duration) error {
+	oldRoutes := make(map[string]map[string]interface{})
+	for _, v := range o.List() {
+		v := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(v)
+		oldRoutes[destination] = v
+	}
+
+	newRoutes := make(map[string]map[string]interface{})
+	for _, v := range n.List() {
+		v := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(v)
+		newRoutes[destination] = v
+	}
+
+	var errs []error
+
+	for _, v := range o.List() {
+		vOld := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(vOld)
+
+		if _, ok := newRoutes[destination]; ok {
+			continue
+		}
+
+		// Local routes cannot be deleted.
+		if _, target := routeTableRouteTargetAttribute(vOld); target == gatewayIDLocal {
+			continue
+		}
+
+		if err := routeTableDeleteRoute(ctx, conn, routeTableID, vOld, timeout); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, v := range n.List() {
+		vNew := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(vNew)
+
+		if vOld, ok := oldRoutes[destination]; ok {
+			_, oldTarget := routeTableRouteTargetAttribute(vOld)
+			_, newTarget := routeTableRouteTargetAttribute(vNew)
+
+			if oldTarget == newTarget {
+				continue
+			}
+
+			if err := routeTableUpdateRoute(ctx, conn, routeTableID, vNew, timeout); err != nil {
+				errs = append(errs, err)
+			}
+
+			continue
+		}
+
+		if err := routeTableAddRoute(ctx, conn, routeTableID, vNew, timeout); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteTableRoutes_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	resourceName := "aws_route_table_routes.test"
+	routeTableResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	natGatewayResourceName := "aws_nat_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr1 := "10.2.0.0/16"
+	destinationCidr2 := "10.3.0.0/16"
+	destinationCidr3 := "10.4.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", routeTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, destinationCidr1, "gateway_id", igwResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, destinationCidr2, "gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Replace one route's target, delete another and create a third in a single apply.
+				Config: testAccVPCRouteTableRoutesConfig_nat(rName, destinationCidr2, destinationCidr3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, destinationCidr2, "nat_gateway_id", natGatewayResourceName, names.AttrID),
+					testAccCheckRouteTableRoute(resourceName, names.AttrCIDRBlock, destinationCidr3, "nat_gateway_id", natGatewayResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_disappears_RouteTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	routeTableResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, "10.2.0.0/16", "10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceRouteTable(), routeTableResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVPCRouteTableRoutesConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
+`, rName))
+}
+
+func testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2 string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), fmt.Sprintf(`
+resource "aws_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block = %[1]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    cidr_block = %[2]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+}
+`, destinationCidr1, destinationCidr2))
+}
+
+func testAccVPCRouteTableRoutesConfig_nat(rName, destinationCidr1, destinationCidr2 string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), fmt.Sprintf(`
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  allocation_id = aws_eip.test.id
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+resource "aws_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block     = %[2]q
+    nat_gateway_id = aws_nat_gateway.test.id
+  }
+
+  route {
+    cidr_block     = %[3]q
+    nat_gateway_id = aws_nat_gateway.test.id
+  }
+}
+`, rName, destinationCidr1, destinationCidr2))
+}
@@ -8,11 +8,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -41,6 +43,26 @@ func (d *securityGroupRulesDataSource) Schema(ctx context.Context, request datas
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"rules": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityGroupRulesRuleModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"cidr_ipv4":                    types.StringType,
+						"cidr_ipv6":                    types.StringType,
+						names.AttrDescription:          types.StringType,
+						"from_port":                    types.Int64Type,
+						"ip_protocol":                  types.StringType,
+						"is_egress":                    types.BoolType,
+						"prefix_list_id":               types.StringType,
+						"referenced_security_group_id": types.StringType,
+						"security_group_id":            types.StringType,
+						"security_group_rule_id":       types.StringType,
+						names.AttrTags:                 types.MapType{ElemType: types.StringType},
+						"to_port":                      types.Int64Type,
+					},
+				},
+			},
 			names.AttrTags: tftags.TagsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
@@ -57,6 +79,7 @@ func (d *securityGroupRulesDataSource) Read(ctx context.Context, request datasou
 	}
 
 	conn := d.Meta().EC2Conn(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 
 	input := &ec2.DescribeSecurityGroupRulesInput{
 		Filters: append(newCustomFilterListFramework(ctx, data.Filters), newTagFilterList(Tags(tftags.New(ctx, data.Tags)))...),
@@ -79,13 +102,46 @@ func (d *securityGroupRulesDataSource) Read(ctx context.Context, request datasou
 	data.IDs = flex.FlattenFrameworkStringValueList(ctx, tfslices.ApplyToAll(output, func(v *ec2.SecurityGroupRule) string {
 		return aws.StringValue(v.SecurityGroupRuleId)
 	}))
+	// The rules are flattened to match the arguments of aws_vpc_security_group_ingress_rule and aws_vpc_security_group_egress_rule.
+	data.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, tfslices.ApplyToAll(output, func(v *ec2.SecurityGroupRule) *securityGroupRulesRuleModel {
+		return &securityGroupRulesRuleModel{
+			CIDRIPv4:                  flex.StringToFramework(ctx, v.CidrIpv4),
+			CIDRIPv6:                  flex.StringToFramework(ctx, v.CidrIpv6),
+			Description:               flex.StringToFramework(ctx, v.Description),
+			FromPort:                  flex.Int64ToFramework(ctx, v.FromPort),
+			IPProtocol:                flex.StringToFramework(ctx, v.IpProtocol),
+			IsEgress:                  flex.BoolToFramework(ctx, v.IsEgress),
+			PrefixListID:              flex.StringToFramework(ctx, v.PrefixListId),
+			ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, v.ReferencedGroupInfo, d.Meta().AccountID),
+			SecurityGroupID:           flex.StringToFramework(ctx, v.GroupId),
+			SecurityGroupRuleID:       flex.StringToFramework(ctx, v.SecurityGroupRuleId),
+			Tags:                      flex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()),
+			ToPort:                    flex.Int64ToFramework(ctx, v.ToPort),
+		}
+	}))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type securityGroupRulesDataSourceModel struct {
-	Filters types.Set    `tfsdk:"filter"`
-	ID      types.String `tfsdk:"id"`
-	IDs     types.List   `tfsdk:"ids"`
-	Tags    types.Map    `tfsdk:"tags"`
+	Filters types.Set                                                    `tfsdk:"filter"`
+	ID      types.String                                                 `tfsdk:"id"`
+	IDs     types.List                                                   `tfsdk:"ids"`
+	Rules   fwtypes.ListNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"rules"`
+	Tags    types.Map                                                    `tfsdk:"tags"`
+}
+
+type securityGroupRulesRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	IsEgress                  types.Bool   `tfsdk:"is_egress"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
+	Tags                      types.Map    `tfsdk:"tags"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
 }
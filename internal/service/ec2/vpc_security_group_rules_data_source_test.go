@@ -26,6 +26,50 @@ func TestAccVPCSecurityGroupRulesDataSource_basic(t *testing.T) {
 				Config: testAccVPCSecurityGroupRulesDataSourceConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_vpc_security_group_rules.test", "rules.0.security_group_rule_id", "aws_vpc_security_group_ingress_rule.test", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.from_port", "80"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.ip_protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.is_egress", acctest.CtFalse),
+					resource.TestCheckResourceAttr("data.aws_vpc_security_group_rules.test", "rules.0.to_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesDataSource_legacyRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesDataSourceConfig_legacyRules(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						"cidr_ipv4":           "10.0.0.0/8",
+						names.AttrDescription: "ingress from 10/8",
+						"from_port":           "443",
+						"ip_protocol":         "tcp",
+						"is_egress":           acctest.CtFalse,
+						"to_port":             "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.*", map[string]string{
+						names.AttrDescription: "egress to peer",
+						"from_port":           "5432",
+						"ip_protocol":         "tcp",
+						"is_egress":           acctest.CtTrue,
+						"to_port":             "5432",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "rules.*.referenced_security_group_id", "aws_security_group.peer", names.AttrID),
 				),
 			},
 		},
@@ -108,3 +152,45 @@ data "aws_vpc_security_group_rules" "test" {
 }
 `, rName))
 }
+
+func testAccVPCSecurityGroupRulesDataSourceConfig_legacyRules(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "peer" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-peer"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group_rule" "ingress" {
+  security_group_id = aws_security_group.test.id
+  type              = "ingress"
+  description       = "ingress from 10/8"
+  cidr_blocks       = ["10.0.0.0/8"]
+  from_port         = 443
+  protocol          = "tcp"
+  to_port           = 443
+}
+
+resource "aws_security_group_rule" "egress" {
+  security_group_id        = aws_security_group.test.id
+  type                     = "egress"
+  description              = "egress to peer"
+  source_security_group_id = aws_security_group.peer.id
+  from_port                = 5432
+  protocol                 = "tcp"
+  to_port                  = 5432
+}
+
+data "aws_vpc_security_group_rules" "test" {
+  filter {
+    name   = "group-id"
+    values = [aws_security_group.test.id]
+  }
+
+  depends_on = [aws_security_group_rule.ingress, aws_security_group_rule.egress]
+}
+`, rName))
+}
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -39,7 +41,10 @@ func ResourceSubnet() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSubnetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -158,6 +163,41 @@ func ResourceSubnet() *schema.Resource {
 	}
 }
 
+func resourceSubnetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Values that aren't known yet, e.g. CIDR blocks computed from the VPC's, are checked by the EC2 API at apply time.
+	if diff.Get("ipv6_native").(bool) {
+		// IPv6-only subnets.
+		if v := diff.Get(names.AttrCIDRBlock).(string); v != "" {
+			return fmt.Errorf("%s must not be set for an IPv6-only (ipv6_native) subnet", names.AttrCIDRBlock)
+		}
+
+		if diff.NewValueKnown("ipv6_cidr_block") && diff.Get("ipv6_cidr_block").(string) == "" {
+			return errors.New("ipv6_cidr_block must be set for an IPv6-only (ipv6_native) subnet")
+		}
+
+		if diff.Get("map_public_ip_on_launch").(bool) {
+			return errors.New("map_public_ip_on_launch must not be true for an IPv6-only (ipv6_native) subnet")
+		}
+
+		if diff.Get("enable_resource_name_dns_a_record_on_launch").(bool) {
+			return errors.New("enable_resource_name_dns_a_record_on_launch must not be true for an IPv6-only (ipv6_native) subnet")
+		}
+
+		if v := diff.Get("private_dns_hostname_type_on_launch").(string); v != "" && v != ec2.HostnameTypeResourceName {
+			return fmt.Errorf("private_dns_hostname_type_on_launch must be %q for an IPv6-only (ipv6_native) subnet", ec2.HostnameTypeResourceName)
+		}
+	} else if diff.Id() == "" && diff.NewValueKnown(names.AttrCIDRBlock) && diff.Get(names.AttrCIDRBlock).(string) == "" {
+		return fmt.Errorf("%s must be set unless the subnet is IPv6-only (ipv6_native)", names.AttrCIDRBlock)
+	}
+
+	// DNS64 synthesizes IPv6 addresses, so the subnet must have an IPv6 CIDR block.
+	if diff.Get("enable_dns64").(bool) && diff.NewValueKnown("ipv6_cidr_block") && diff.Get("ipv6_cidr_block").(string) == "" {
+		return errors.New("ipv6_cidr_block must be set when enable_dns64 is true")
+	}
+
+	return nil
+}
+
 func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -600,6 +600,62 @@ func TestAccVPCSubnet_ipv6Native(t *testing.T) {
 	})
 }
 
+func TestAccVPCSubnet_ipv6NativeEnableDNS64(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Subnet
+	resourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetConfig_ipv6NativeEnableDNS64(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrCIDRBlock, ""),
+					resource.TestCheckResourceAttr(resourceName, "enable_dns64", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_native", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnet_ipv6NativeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `cidr_block = "10.1.1.0/24"`),
+				ExpectError: regexache.MustCompile(`cidr_block must not be set for an IPv6-only \(ipv6_native\) subnet`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `map_public_ip_on_launch = true`),
+				ExpectError: regexache.MustCompile(`map_public_ip_on_launch must not be true`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `private_dns_hostname_type_on_launch = "ip-name"`),
+				ExpectError: regexache.MustCompile(`private_dns_hostname_type_on_launch must be "resource-name"`),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetIPv6BeforeUpdate(subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -1086,6 +1142,59 @@ resource "aws_subnet" "test" {
 `, rName)
 }
 
+func testAccVPCSubnetConfig_ipv6NativeEnableDNS64(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.10.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id                          = aws_vpc.test.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+  enable_dns64                    = true
+
+  enable_resource_name_dns_aaaa_record_on_launch = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCSubnetConfig_ipv6NativeInvalid(rName, argument string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id                          = aws_vpc.test.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+
+  %[2]s
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, argument)
+}
+
 func testAccVPCSubnetConfig_outpost(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_vpn_connection_device_sample_configuration", name="VPN Connection Device Sample Configuration")
+func dataSourceVPNConnectionDeviceSampleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPNConnectionDeviceSampleConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"internet_key_exchange_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIKEVersion_Values(), false),
+			},
+			"vpn_connection_device_sample_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"vpn_connection_device_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpn_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPNConnectionDeviceSampleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpnConnectionID := d.Get("vpn_connection_id").(string)
+	vpnConnectionDeviceTypeID := d.Get("vpn_connection_device_type_id").(string)
+	input := &ec2.GetVpnConnectionDeviceSampleConfigurationInput{
+		VpnConnectionDeviceTypeId: aws.String(vpnConnectionDeviceTypeID),
+		VpnConnectionId:           aws.String(vpnConnectionID),
+	}
+
+	if v, ok := d.GetOk("internet_key_exchange_version"); ok {
+		input.InternetKeyExchangeVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.GetVpnConnectionDeviceSampleConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPN Connection (%s) Device Sample Configuration: %s", vpnConnectionID, err)
+	}
+
+	d.SetId(strings.Join([]string{vpnConnectionID, vpnConnectionDeviceTypeID}, ","))
+	d.Set("vpn_connection_device_sample_configuration", output.VpnConnectionDeviceSampleConfiguration)
+
+	return diags
+}
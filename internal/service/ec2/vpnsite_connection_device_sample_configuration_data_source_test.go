@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSiteVPNConnectionDeviceSampleConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	dataSourceName := "data.aws_vpn_connection_device_sample_configuration.test"
+	resourceName := "aws_vpn_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName, rBgpAsn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "internet_key_exchange_version", "ikev2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_sample_configuration"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpn_connection_device_type_id", "5fb390ba"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(testAccSiteVPNConnectionConfig_basic(rName, rBgpAsn), `
+data "aws_vpn_connection_device_sample_configuration" "test" {
+  internet_key_exchange_version = "ikev2"
+  vpn_connection_device_type_id = "5fb390ba"
+  vpn_connection_id             = aws_vpn_connection.test.id
+}
+`)
+}
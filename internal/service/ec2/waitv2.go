@@ -92,6 +92,41 @@ func waitCapacityReservationDeleted(ctx context.Context, conn *ec2.Client, id st
 	return nil, err
 }
 
+func waitCapacityReservationFleetActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationFleetStateSubmitted, awstypes.CapacityReservationFleetStateModifying),
+		Target:  enum.Slice(awstypes.CapacityReservationFleetStateActive, awstypes.CapacityReservationFleetStatePartiallyFulfilled),
+		Refresh: statusCapacityReservationFleet(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationFleetStateActive, awstypes.CapacityReservationFleetStatePartiallyFulfilled, awstypes.CapacityReservationFleetStateModifying, awstypes.CapacityReservationFleetStateExpiring, awstypes.CapacityReservationFleetStateCancelling),
+		Target:  []string{},
+		Refresh: statusCapacityReservationFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target []string, timeout, delay time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    pending,
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecs_container_instances")
+func DataSourceContainerInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"container_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_connected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ec2_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registered_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"running_tasks_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.ContainerInstanceStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceContainerInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster := d.Get("cluster").(string)
+	input := &ecs.ListContainerInstancesInput{
+		Cluster: aws.String(cluster),
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	var arns []string
+
+	err := conn.ListContainerInstancesPagesWithContext(ctx, input, func(page *ecs.ListContainerInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		arns = append(arns, aws.StringValueSlice(page.ContainerInstanceArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing ECS Container Instances (%s): %s", cluster, err)
+	}
+
+	var containerInstances []*ecs.ContainerInstance
+
+	// DescribeContainerInstances accepts at most 100 container instances per call.
+	const batchSize = 100
+	for _, chunk := range tfslices.Chunks(arns, batchSize) {
+		output, err := conn.DescribeContainerInstancesWithContext(ctx, &ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(cluster),
+			ContainerInstances: aws.StringSlice(chunk),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "describing ECS Container Instances (%s): %s", cluster, err)
+		}
+
+		containerInstances = append(containerInstances, output.ContainerInstances...)
+	}
+
+	d.SetId(cluster)
+	if err := d.Set("container_instances", flattenContainerInstances(containerInstances)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_instances: %s", err)
+	}
+
+	return diags
+}
+
+func flattenContainerInstances(apiObjects []*ecs.ContainerInstance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"agent_connected":     aws.BoolValue(apiObject.AgentConnected),
+			names.AttrARN:         aws.StringValue(apiObject.ContainerInstanceArn),
+			"ec2_instance_id":     aws.StringValue(apiObject.Ec2InstanceId),
+			"running_tasks_count": aws.Int64Value(apiObject.RunningTasksCount),
+			names.AttrStatus:      aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.RegisteredAt; v != nil {
+			tfMap["registered_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+		for _, v := range apiObject.Attributes {
+			attributes[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+		}
+		tfMap["attributes"] = attributes
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
 		},
+		{
+			Factory:  DataSourceContainerInstances,
+			TypeName: "aws_ecs_container_instances",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
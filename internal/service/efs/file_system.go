@@ -135,6 +135,10 @@ func ResourceFileSystem() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							// The protection of a replication destination is managed by EFS while replication is active.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old == efs.ReplicationOverwriteProtectionReplicating
+							},
 							ValidateFunc: validation.StringInSlice([]string{
 								efs.ReplicationOverwriteProtectionEnabled,
 								efs.ReplicationOverwriteProtectionDisabled,
@@ -56,6 +56,12 @@ func ResourceReplicationConfiguration() *schema.Resource {
 							ForceNew:     true,
 							AtLeastOneOf: []string{"destination.0.availability_zone_name", "destination.0.region"},
 						},
+						"disable_overwrite_protection": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"destination.0.file_system_id"},
+						},
 						names.AttrFileSystemID: {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -116,6 +122,26 @@ func resourceReplicationConfigurationCreate(ctx context.Context, d *schema.Resou
 		input.Destinations = expandDestinationsToCreate(v.([]interface{}))
 	}
 
+	// Replicating into an existing file system (e.g. failing back to the original source after a failover)
+	// requires its replication overwrite protection to be disabled first.
+	if d.Get("destination.0.disable_overwrite_protection").(bool) {
+		destination := input.Destinations[0]
+		destinationConn := conn
+		if v := aws.StringValue(destination.Region); v != "" {
+			destinationConn = meta.(*conns.AWSClient).EFSConnForRegion(ctx, v)
+		}
+		destinationFSID := aws.StringValue(destination.FileSystemId)
+
+		_, err := destinationConn.UpdateFileSystemProtectionWithContext(ctx, &efs.UpdateFileSystemProtectionInput{
+			FileSystemId:                   aws.String(destinationFSID),
+			ReplicationOverwriteProtection: aws.String(efs.ReplicationOverwriteProtectionDisabled),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling EFS file system (%s) replication overwrite protection: %s", destinationFSID, err)
+		}
+	}
+
 	_, err := conn.CreateReplicationConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -149,13 +175,14 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 
 	destinations := flattenDestinations(replication.Destinations)
 
-	// availability_zone_name and kms_key_id aren't returned from the AWS Read API.
+	// availability_zone_name, disable_overwrite_protection and kms_key_id aren't returned from the AWS Read API.
 	if v, ok := d.GetOk(names.AttrDestination); ok && len(v.([]interface{})) > 0 {
 		copy := func(i int, k string) {
 			destinations[i].(map[string]interface{})[k] = v.([]interface{})[i].(map[string]interface{})[k]
 		}
 		// Assume 1 destination.
 		copy(0, "availability_zone_name")
+		copy(0, "disable_overwrite_protection")
 		copy(0, names.AttrKMSKeyID)
 	}
 
@@ -163,6 +163,54 @@ func TestAccEFSReplicationConfiguration_existingDestination(t *testing.T) {
 	})
 }
 
+func TestAccEFSReplicationConfiguration_failback(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_efs_replication_configuration.test"
+	reverseResourceName := "aws_efs_replication_configuration.reverse"
+	primaryFsResourceName := "aws_efs_file_system.primary"
+	secondaryFsResourceName := "aws_efs_file_system.secondary"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_failback(rName, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", secondaryFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+				),
+			},
+			// Fail over: deleting the replication configuration makes the secondary file system writable.
+			{
+				Config: testAccReplicationConfigurationConfig_failback(rName, false, false),
+			},
+			// Fail back: replicate the secondary file system into the original primary file system.
+			{
+				Config: testAccReplicationConfigurationConfig_failback(rName, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(reverseResourceName, "source_file_system_id", secondaryFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(reverseResourceName, "destination.0.file_system_id", primaryFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(reverseResourceName, "destination.0.disable_overwrite_protection", acctest.CtTrue),
+					resource.TestCheckResourceAttr(reverseResourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -300,3 +348,60 @@ resource "aws_efs_replication_configuration" "test" {
 }
 `, rName, acctest.AlternateRegion()))
 }
+
+func testAccReplicationConfigurationConfig_failback(rName string, replicate, reverse bool) string {
+	config := acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_efs_file_system" "primary" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_file_system" "secondary" {
+  provider = "awsalternate"
+
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [protection]
+  }
+}
+`, rName))
+
+	if replicate {
+		config = acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_efs_replication_configuration" "test" {
+  source_file_system_id = aws_efs_file_system.primary.id
+
+  destination {
+    file_system_id = aws_efs_file_system.secondary.id
+    region         = %[1]q
+  }
+}
+`, acctest.AlternateRegion()))
+	}
+
+	if reverse {
+		config = acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_efs_replication_configuration" "reverse" {
+  provider = "awsalternate"
+
+  source_file_system_id = aws_efs_file_system.secondary.id
+
+  destination {
+    disable_overwrite_protection = true
+    file_system_id               = aws_efs_file_system.primary.id
+    region                       = %[1]q
+  }
+}
+`, acctest.Region()))
+	}
+
+	return config
+}
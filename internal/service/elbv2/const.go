@@ -26,10 +26,11 @@ const (
 	loadBalancerAttributeLoadBalancingCrossZoneEnabled = "load_balancing.cross_zone.enabled"
 
 	// The following attributes are supported by both Application Load Balancers and Network Load Balancers:
-	loadBalancerAttributeAccessLogsS3Enabled   = "access_logs.s3.enabled"
-	loadBalancerAttributeAccessLogsS3Bucket    = "access_logs.s3.bucket"
-	loadBalancerAttributeAccessLogsS3Prefix    = "access_logs.s3.prefix"
-	loadBalancerAttributeIPv6DenyAllIGWTraffic = "ipv6.deny_all_igw_traffic"
+	loadBalancerAttributeAccessLogsS3Enabled     = "access_logs.s3.enabled"
+	loadBalancerAttributeAccessLogsS3Bucket      = "access_logs.s3.bucket"
+	loadBalancerAttributeAccessLogsS3Prefix      = "access_logs.s3.prefix"
+	loadBalancerAttributeIPv6DenyAllIGWTraffic   = "ipv6.deny_all_igw_traffic"
+	loadBalancerAttributeZonalShiftConfigEnabled = "zonal_shift.config.enabled"
 
 	// The following attributes are supported by only Application Load Balancers:
 	loadBalancerAttributeIdleTimeoutTimeoutSeconds                       = "idle_timeout.timeout_seconds"
@@ -122,6 +123,7 @@ const (
 	targetGroupAttributePreserveClientIPEnabled                                = "preserve_client_ip.enabled"
 	targetGroupAttributeProxyProtocolV2Enabled                                 = "proxy_protocol_v2.enabled"
 	targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled = "target_health_state.unhealthy.connection_termination.enabled"
+	targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds      = "target_health_state.unhealthy.draining_interval_seconds"
 
 	// The following attributes are supported only by Gateway Load Balancers:
 	targetGroupAttributeTargetFailoverOnDeregistration = "target_failover.on_deregistration"
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -18,6 +20,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -395,6 +398,7 @@ func ResourceListener() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			validateListenerActionsCustomDiff(names.AttrDefaultAction),
+			validateListenerSSLPolicyCustomDiff,
 		),
 	}
 }
@@ -503,6 +507,11 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting ELBv2 Listener (%s) tags: %s", d.Id(), err)
 		}
+
+		// Tags added after create are eventually consistent.
+		if err := waitTagsPropagatedV2(ctx, conn, d.Id(), nil, keyValueTagsV2(ctx, tags)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Listener (%s) tag propagation: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
@@ -686,6 +695,91 @@ func findListeners(ctx context.Context, conn *elasticloadbalancingv2.Client, inp
 	return output, nil
 }
 
+// sslPolicyNamesCache caches the names of the SSL policies supported by each load balancer type in each Region,
+// avoiding repeated DescribeSSLPolicies calls when planning many listeners.
+var sslPolicyNamesCache = &sslPolicyNamesCacheT{
+	entries: make(map[string]*sslPolicyNamesCacheEntry),
+}
+
+type sslPolicyNamesCacheT struct {
+	lock    sync.Mutex
+	entries map[string]*sslPolicyNamesCacheEntry
+}
+
+// sslPolicyNamesCacheEntry holds the result of a single DescribeSSLPolicies lookup.
+// ready is closed once names and err are set.
+type sslPolicyNamesCacheEntry struct {
+	ready chan struct{}
+	names []string
+	err   error
+}
+
+func (c *sslPolicyNamesCacheT) get(ctx context.Context, conn *elasticloadbalancingv2.Client, region string, loadBalancerType awstypes.LoadBalancerTypeEnum) ([]string, error) {
+	key := region + "/" + string(loadBalancerType)
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &sslPolicyNamesCacheEntry{
+			ready: make(chan struct{}),
+		}
+		c.entries[key] = entry
+	}
+	c.lock.Unlock()
+
+	// Only the first caller for a key calls the API; concurrent callers wait for its result.
+	// The lock isn't held during the API call so that listeners for other Regions and load balancer types aren't blocked.
+	if !ok {
+		output, err := findSSLPolicies(ctx, conn, &elasticloadbalancingv2.DescribeSSLPoliciesInput{
+			LoadBalancerType: loadBalancerType,
+		})
+
+		if err != nil {
+			entry.err = err
+
+			// Errors aren't cached so that a later plan can retry.
+			c.lock.Lock()
+			delete(c.entries, key)
+			c.lock.Unlock()
+		} else {
+			entry.names = tfslices.ApplyToAll(output, func(v awstypes.SslPolicy) string {
+				return aws.ToString(v.Name)
+			})
+		}
+
+		close(entry.ready)
+	}
+
+	select {
+	case <-entry.ready:
+		return entry.names, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func findSSLPolicies(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeSSLPoliciesInput) ([]awstypes.SslPolicy, error) {
+	var output []awstypes.SslPolicy
+
+	for {
+		page, err := conn.DescribeSSLPolicies(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SslPolicies...)
+
+		if aws.ToString(page.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = page.NextMarker
+	}
+
+	return output, nil
+}
+
 func expandLbListenerActions(actionsPath cty.Path, l []any, diags *diag.Diagnostics) []awstypes.Action {
 	if len(l) == 0 {
 		return nil
@@ -1241,6 +1335,47 @@ func validateListenerActionsCustomDiff(attrName string) schema.CustomizeDiffFunc
 	}
 }
 
+// validateListenerSSLPolicyCustomDiff checks that a new or changed ssl_policy is supported by the listener's load balancer type.
+func validateListenerSSLPolicyCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange("ssl_policy") || !d.NewValueKnown("ssl_policy") || !d.NewValueKnown("load_balancer_arn") {
+		return nil
+	}
+
+	sslPolicy := d.Get("ssl_policy").(string)
+	if sslPolicy == "" {
+		return nil
+	}
+
+	var loadBalancerType awstypes.LoadBalancerTypeEnum
+	switch lbARN := d.Get("load_balancer_arn").(string); {
+	case strings.Contains(lbARN, "loadbalancer/app/"):
+		loadBalancerType = awstypes.LoadBalancerTypeEnumApplication
+	case strings.Contains(lbARN, "loadbalancer/net/"):
+		loadBalancerType = awstypes.LoadBalancerTypeEnumNetwork
+	default:
+		return nil
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+	sslPolicyNames, err := sslPolicyNamesCache.get(ctx, awsClient.ELBV2Client(ctx), awsClient.Region, loadBalancerType)
+
+	// Don't prevent planning if the supported policies can't be listed, e.g. due to missing permissions.
+	if err != nil {
+		tflog.Warn(ctx, "Unable to validate ELBv2 Listener ssl_policy, skipping", map[string]any{
+			"ssl_policy":         sslPolicy,
+			"load_balancer_type": loadBalancerType,
+			"error":              err.Error(),
+		})
+		return nil
+	}
+
+	if !slices.Contains(sslPolicyNames, sslPolicy) {
+		return fmt.Errorf("ssl_policy %q is not supported by %s load balancers", sslPolicy, loadBalancerType)
+	}
+
+	return nil
+}
+
 func listenerActionsPlantimeValidate(actionsPath cty.Path, actions cty.Value, diags *diag.Diagnostics) {
 	it := actions.ElementIterator()
 	for it.Next() {
@@ -554,6 +554,11 @@ func resourceListenerRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting ELBv2 Listener Rule (%s) tags: %s", d.Id(), err)
 		}
+
+		// Tags added after create are eventually consistent.
+		if err := waitTagsPropagatedV2(ctx, conn, d.Id(), nil, keyValueTagsV2(ctx, tags)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Listener Rule (%s) tag propagation: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceListenerRuleRead(ctx, d, meta)...)
@@ -997,6 +997,42 @@ func TestAccELBV2Listener_backwardsCompatibility(t *testing.T) {
 	})
 }
 
+func TestAccELBV2Listener_Protocol_https_sslPolicyValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Listener
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	resourceName := "aws_lb_listener.test"
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerConfig_https(rName, key, certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ssl_policy", "ELBSecurityPolicy-2016-08"),
+				),
+			},
+			{
+				Config: testAccListenerConfig_httpsSSLPolicy(rName, key, certificate, "ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ssl_policy", "ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				),
+			},
+			{
+				Config:      testAccListenerConfig_httpsSSLPolicy(rName, key, certificate, "ELBSecurityPolicy-TLS13-Invalid"),
+				ExpectError: regexache.MustCompile(`ssl_policy "ELBSecurityPolicy-TLS13-Invalid" is not supported by application load balancers`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2Listener_Protocol_https(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Listener
@@ -2800,12 +2836,16 @@ resource "aws_alb_target_group" "test" {
 }
 
 func testAccListenerConfig_https(rName, key, certificate string) string {
+	return testAccListenerConfig_httpsSSLPolicy(rName, key, certificate, "ELBSecurityPolicy-2016-08")
+}
+
+func testAccListenerConfig_httpsSSLPolicy(rName, key, certificate, sslPolicy string) string {
 	return acctest.ConfigCompose(testAccListenerConfig_base(rName), fmt.Sprintf(`
 resource "aws_lb_listener" "test" {
   load_balancer_arn = aws_lb.test.id
   protocol          = "HTTPS"
   port              = "443"
-  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  ssl_policy        = %[4]q
   certificate_arn   = aws_iam_server_certificate.test.arn
 
   default_action {
@@ -2863,7 +2903,7 @@ resource "aws_internet_gateway" "test" {
     Name = %[1]q
   }
 }
-`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key)))
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key), sslPolicy))
 }
 
 func testAccListenerConfig_mutualAuthentication(rName string, key, certificate string) string {
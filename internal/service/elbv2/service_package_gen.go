@@ -57,6 +57,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTargetGroup,
 			TypeName: "aws_lb_target_group",
 		},
+		{
+			Factory:  dataSourceTargetHealth,
+			TypeName: "aws_lb_target_health",
+			Name:     "Target Health",
+		},
 		{
 			Factory:  DataSourceTrustStore,
 			TypeName: "aws_lb_trust_store",
@@ -4,6 +4,7 @@ package elbv2
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -153,3 +154,18 @@ func updateTags(ctx context.Context, conn elbv2iface.ELBV2API, identifier string
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
 	return updateTags(ctx, meta.(*conns.AWSClient).ELBV2Conn(ctx), identifier, oldTags, newTags)
 }
+
+// waitTagsPropagated waits for elbv2 service tags to be propagated.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func waitTagsPropagated(ctx context.Context, conn elbv2iface.ELBV2API, id string, oldTags, newTags tftags.KeyValueTags) error {
+	config := tftags.PropagationConfig{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+		Timeout:                   2 * time.Minute,
+	}
+
+	return tftags.WaitPropagated(ctx, oldTags, newTags, func(ctx context.Context) (tftags.KeyValueTags, error) {
+		return listTags(ctx, conn, id)
+	}, config)
+}
@@ -4,6 +4,7 @@ package elbv2
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
@@ -130,3 +131,18 @@ func updateTagsV2(ctx context.Context, conn *elasticloadbalancingv2.Client, iden
 
 	return nil
 }
+
+// waitTagsPropagatedV2 waits for elbv2 service tags to be propagated.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func waitTagsPropagatedV2(ctx context.Context, conn *elasticloadbalancingv2.Client, id string, oldTags, newTags tftags.KeyValueTags, optFns ...func(*elasticloadbalancingv2.Options)) error {
+	config := tftags.PropagationConfig{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+		Timeout:                   2 * time.Minute,
+	}
+
+	return tftags.WaitPropagated(ctx, oldTags, newTags, func(ctx context.Context) (tftags.KeyValueTags, error) {
+		return listTagsV2(ctx, conn, id, optFns...)
+	}, config)
+}
@@ -325,6 +325,12 @@ func ResourceTargetGroup() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+						"unhealthy_draining_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 360000),
+						},
 					},
 				},
 			},
@@ -512,6 +518,11 @@ func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting ELBv2 Target Group (%s) tags: %s", d.Id(), err)
 		}
+
+		// Tags added after create are eventually consistent.
+		if err := waitTagsPropagated(ctx, conn, d.Id(), nil, keyValueTags(ctx, tags)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Target Group (%s) tag propagation: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceTargetGroupRead(ctx, d, meta)...)
@@ -1260,6 +1271,17 @@ func expandTargetGroupTargetHealthStateAttributes(tfMap map[string]interface{},
 				Key:   aws.String(targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled),
 				Value: flex.BoolValueToString(tfMap["enable_unhealthy_connection_termination"].(bool)),
 			})
+
+		// The draining interval can only be configured when unhealthy connection termination is disabled.
+		if !tfMap["enable_unhealthy_connection_termination"].(bool) {
+			if v, ok := tfMap["unhealthy_draining_interval"].(int); ok {
+				apiObjects = append(apiObjects,
+					&elbv2.TargetGroupAttribute{
+						Key:   aws.String(targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds),
+						Value: flex.IntValueToString(v),
+					})
+			}
+		}
 	}
 
 	return apiObjects
@@ -1278,6 +1300,8 @@ func flattenTargetGroupTargetHealthStateAttributes(apiObjects []*elbv2.TargetGro
 			switch k, v := aws.StringValue(apiObject.Key), apiObject.Value; k {
 			case targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled:
 				tfMap["enable_unhealthy_connection_termination"] = flex.StringToBoolValue(v)
+			case targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds:
+				tfMap["unhealthy_draining_interval"] = flex.StringToIntValue(v)
 			}
 		}
 	}
@@ -2554,6 +2554,40 @@ func TestAccELBV2TargetGroup_targetHealthStateUnhealthyConnectionTermination(t *
 	})
 }
 
+func TestAccELBV2TargetGroup_targetHealthStateUnhealthyDrainingInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var targetGroup elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_targetHealthStateUnhealthyDrainingInterval(rName, "TCP", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "600"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_targetHealthStateUnhealthyDrainingInterval(rName, "TCP", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_Instance_HealthCheck_defaults(t *testing.T) {
 	t.Parallel()
 
@@ -4868,6 +4902,30 @@ resource "aws_vpc" "test" {
 `, protocol, stickyType, enabled, loadBalanceAlgorithmType, rName)
 }
 
+func testAccTargetGroupConfig_targetHealthStateUnhealthyDrainingInterval(rName, protocol string, interval int) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 25
+  protocol = %[2]q
+  vpc_id   = aws_vpc.test.id
+
+  target_health_state {
+    enable_unhealthy_connection_termination = false
+    unhealthy_draining_interval             = %[3]d
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, protocol, interval)
+}
+
 func testAccTargetGroupConfig_targetHealthStateConnectionTermination(rName, protocol string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lb_target_health", name="Target Health")
+func dataSourceTargetHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTargetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrTarget: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"target_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_health_descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"anomaly_detection_mitigation_in_effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anomaly_detection_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	targetGroupARN := d.Get("target_group_arn").(string)
+	input := &elbv2.DescribeTargetHealthInput{
+		Include:        aws.StringSlice([]string{elbv2.DescribeTargetHealthInputIncludeEnumAll}),
+		TargetGroupArn: aws.String(targetGroupARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrTarget); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Targets = []*elbv2.TargetDescription{expandTargetDescription(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	output, err := findTargetHealthDescriptions(ctx, conn, input, tfslices.PredicateTrue[*elbv2.TargetHealthDescription]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Target Group (%s) target health: %s", targetGroupARN, err)
+	}
+
+	d.SetId(targetGroupARN)
+	if err := d.Set("target_health_descriptions", flattenTargetHealthDescriptions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_health_descriptions: %s", err)
+	}
+
+	return diags
+}
+
+func expandTargetDescription(tfMap map[string]interface{}) *elbv2.TargetDescription {
+	apiObject := &elbv2.TargetDescription{
+		Id: aws.String(tfMap[names.AttrID].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrAvailabilityZone].(string); ok && v != "" {
+		apiObject.AvailabilityZone = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetHealthDescriptions(apiObjects []*elbv2.TargetHealthDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"health_check_port": aws.StringValue(apiObject.HealthCheckPort),
+		}
+
+		if v := apiObject.AnomalyDetection; v != nil {
+			tfMap["anomaly_detection_mitigation_in_effect"] = aws.StringValue(v.MitigationInEffect)
+			tfMap["anomaly_detection_result"] = aws.StringValue(v.Result)
+		}
+
+		if v := apiObject.Target; v != nil {
+			tfMap[names.AttrAvailabilityZone] = aws.StringValue(v.AvailabilityZone)
+			tfMap[names.AttrID] = aws.StringValue(v.Id)
+			tfMap[names.AttrPort] = aws.Int64Value(v.Port)
+		}
+
+		if v := apiObject.TargetHealth; v != nil {
+			tfMap[names.AttrDescription] = aws.StringValue(v.Description)
+			tfMap["reason"] = aws.StringValue(v.Reason)
+			tfMap[names.AttrState] = aws.StringValue(v.State)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2TargetHealthDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_health.test"
+	instanceResourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_group_arn", "aws_lb_target_group.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_health_descriptions.0.id", instanceResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.port", "443"),
+					// The target group isn't used by a load balancer.
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.state", "unused"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "target_health_descriptions.0.reason"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetHealthDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTargetGroupAttachmentConfig_idInstance(rName), `
+data "aws_lb_target_health" "test" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+
+  target {
+    id = aws_lb_target_group_attachment.test.target_id
+  }
+}
+`)
+}
@@ -159,6 +159,11 @@ func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting ELBv2 Trust Store (%s) tags: %s", d.Id(), err)
 		}
+
+		// Tags added after create are eventually consistent.
+		if err := waitTagsPropagated(ctx, conn, d.Id(), nil, keyValueTags(ctx, tags)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Trust Store (%s) tag propagation: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
@@ -724,6 +724,10 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -742,6 +746,10 @@ func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -758,6 +766,10 @@ func waitKxClusterDeleted(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
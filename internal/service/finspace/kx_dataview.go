@@ -71,6 +71,7 @@ func ResourceKxDataview() *schema.Resource {
 			"changeset_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"created_timestamp": {
 				Type:     schema.TypeString,
@@ -263,27 +264,36 @@ func resourceKxDataviewRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceKxDataviewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FinSpaceClient(ctx)
-	in := &finspace.UpdateKxDataviewInput{
-		EnvironmentId: aws.String(d.Get("environment_id").(string)),
-		DatabaseName:  aws.String(d.Get(names.AttrDatabaseName).(string)),
-		DataviewName:  aws.String(d.Get(names.AttrName).(string)),
-		ClientToken:   aws.String(id.UniqueId()),
-	}
 
-	if v, ok := d.GetOk("changeset_id"); ok && d.HasChange("changeset_id") && !d.Get("auto_update").(bool) {
-		in.ChangesetId = aws.String(v.(string))
-	}
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &finspace.UpdateKxDataviewInput{
+			EnvironmentId: aws.String(d.Get("environment_id").(string)),
+			DatabaseName:  aws.String(d.Get(names.AttrDatabaseName).(string)),
+			DataviewName:  aws.String(d.Get(names.AttrName).(string)),
+			ClientToken:   aws.String(id.UniqueId()),
+		}
 
-	if v, ok := d.GetOk("segment_configurations"); ok && len(v.([]interface{})) > 0 && d.HasChange("segment_configurations") {
-		in.SegmentConfigurations = expandSegmentConfigurations(v.([]interface{}))
-	}
+		if d.HasChange(names.AttrDescription) {
+			in.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
 
-	if _, err := conn.UpdateKxDataview(ctx, in); err != nil {
-		return create.AppendDiagError(diags, names.FinSpace, create.ErrActionUpdating, ResNameKxDataview, d.Get(names.AttrName).(string), err)
-	}
+		// Dataviews with auto-update enabled track the latest changeset, so an explicit
+		// changeset is only sent when auto-update is disabled.
+		if v, ok := d.GetOk("changeset_id"); ok && d.HasChange("changeset_id") && !d.Get("auto_update").(bool) {
+			in.ChangesetId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("segment_configurations"); ok && len(v.([]interface{})) > 0 && d.HasChange("segment_configurations") {
+			in.SegmentConfigurations = expandSegmentConfigurations(v.([]interface{}))
+		}
 
-	if _, err := waitKxDataviewUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return create.AppendDiagError(diags, names.FinSpace, create.ErrActionWaitingForUpdate, ResNameKxDataview, d.Get(names.AttrName).(string), err)
+		if _, err := conn.UpdateKxDataview(ctx, in); err != nil {
+			return create.AppendDiagError(diags, names.FinSpace, create.ErrActionUpdating, ResNameKxDataview, d.Get(names.AttrName).(string), err)
+		}
+
+		if _, err := waitKxDataviewUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.FinSpace, create.ErrActionWaitingForUpdate, ResNameKxDataview, d.Get(names.AttrName).(string), err)
+		}
 	}
 
 	return append(diags, resourceKxDataviewRead(ctx, d, meta)...)
@@ -357,6 +367,10 @@ func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 	return nil, err
@@ -374,6 +388,10 @@ func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 	return nil, err
@@ -389,6 +407,10 @@ func waitKxDataviewDeleted(ctx context.Context, conn *finspace.Client, id string
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -148,6 +148,44 @@ func TestAccFinSpaceKxDataview_onDemand(t *testing.T) {
 	})
 }
 
+func TestAccFinSpaceKxDataview_description(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping test in short mode.")
+	}
+
+	ctx := acctest.Context(t)
+	var dataview finspace.GetKxDataviewOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_finspace_kx_dataview.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, finspace.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKxDataviewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKxDataviewConfig_description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKxDataviewExists(ctx, resourceName, &dataview),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+				),
+			},
+			{
+				Config: testAccKxDataviewConfig_description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKxDataviewExists(ctx, resourceName, &dataview),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.KxDataviewStatusActive)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFinSpaceKxDataview_tags(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping test in short mode.")
@@ -318,6 +356,22 @@ resource "aws_finspace_kx_dataview" "test" {
 `, rName))
 }
 
+func testAccKxDataviewConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccKxDataviewConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_finspace_kx_dataview" "test" {
+  name                 = %[1]q
+  environment_id       = aws_finspace_kx_environment.test.id
+  database_name        = aws_finspace_kx_database.test.name
+  description          = %[2]q
+  auto_update          = true
+  az_mode              = "SINGLE"
+  availability_zone_id = aws_finspace_kx_environment.test.availability_zones[0]
+}
+`, rName, description))
+}
+
 func testAccKxDataviewConfig_readWrite(rName string) string {
 	return acctest.ConfigCompose(
 		testAccKxDataviewConfigBase(rName),
@@ -254,6 +254,10 @@ func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -270,6 +274,10 @@ func waitKxScalingGroupDeleted(ctx context.Context, conn *finspace.Client, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
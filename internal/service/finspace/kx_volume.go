@@ -340,6 +340,10 @@ func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -349,7 +353,7 @@ func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string,
 func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.KxVolumeStatusCreating, types.KxVolumeStatusUpdating),
-		Target:                    enum.Slice(types.KxVolumeStatusActive),
+		Target:                    enum.Slice(types.KxVolumeStatusActive, types.KxVolumeStatusUpdated),
 		Refresh:                   statusKxVolume(ctx, conn, id),
 		Timeout:                   timeout,
 		NotFoundChecks:            20,
@@ -358,6 +362,10 @@ func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -374,6 +382,10 @@ func waitKxVolumeDeleted(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		if v := aws.ToString(out.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
 		return out, err
 	}
 
@@ -184,8 +184,9 @@ func resourceFileCache() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"lustre_configuration": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"deployment_type": {
@@ -213,10 +214,10 @@ func resourceFileCache() *schema.Resource {
 							},
 						},
 						"metadata_configuration": {
-							Type:     schema.TypeSet,
+							Type:     schema.TypeList,
 							Required: true,
 							ForceNew: true,
-							MaxItems: 8,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"storage_capacity": {
@@ -245,6 +246,7 @@ func resourceFileCache() *schema.Resource {
 						"weekly_maintenance_start_time": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ValidateFunc: validation.All(
 								validation.StringLenBetween(7, 7),
 								validation.StringMatch(regexache.MustCompile(`^[1-7]:([01]\d|2[0-3]):?([0-5]\d)$`), "invalid pattern"),
@@ -322,8 +324,8 @@ func resourceFileCacheCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("lustre_configuration"); ok && len(v.(*schema.Set).List()) > 0 {
-		input.LustreConfiguration = expandCreateFileCacheLustreConfiguration(v.(*schema.Set).List())
+	if v, ok := d.GetOk("lustre_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.LustreConfiguration = expandCreateFileCacheLustreConfiguration(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk(names.AttrSecurityGroupIDs); ok {
@@ -384,7 +386,7 @@ func resourceFileCacheRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading FSx for Lustre  Data Repository Associations: %s", err)
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	if err := d.Set("data_repository_association", flattenDataRepositoryAssociations(ctx, dataRepositoryAssociations, defaultTagsConfig, ignoreTagsConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting data_repository_association: %s", err)
@@ -718,7 +720,7 @@ func expandUpdateFileCacheLustreConfiguration(l []interface{}) *fsx.UpdateFileCa
 	data := l[0].(map[string]interface{})
 	req := &fsx.UpdateFileCacheLustreConfiguration{}
 
-	if v, ok := data["weekly_maintenance_start_time"].(string); ok {
+	if v, ok := data["weekly_maintenance_start_time"].(string); ok && v != "" {
 		req.WeeklyMaintenanceStartTime = aws.String(v)
 	}
 
@@ -735,13 +737,13 @@ func expandCreateFileCacheLustreConfiguration(l []interface{}) *fsx.CreateFileCa
 	if v, ok := data["deployment_type"].(string); ok {
 		req.DeploymentType = aws.String(v)
 	}
-	if v, ok := data["metadata_configuration"]; ok && len(v.(*schema.Set).List()) > 0 {
-		req.MetadataConfiguration = expandFileCacheLustreMetadataConfiguration(v.(*schema.Set).List())
+	if v, ok := data["metadata_configuration"].([]interface{}); ok && len(v) > 0 {
+		req.MetadataConfiguration = expandFileCacheLustreMetadataConfiguration(v)
 	}
 	if v, ok := data["per_unit_storage_throughput"].(int); ok {
 		req.PerUnitStorageThroughput = aws.Int64(int64(v))
 	}
-	if v, ok := data["weekly_maintenance_start_time"].(string); ok {
+	if v, ok := data["weekly_maintenance_start_time"].(string); ok && v != "" {
 		req.WeeklyMaintenanceStartTime = aws.String(v)
 	}
 
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_fsx_file_cache", name="File Cache")
+func dataSourceFileCache() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFileCacheRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_repository_association_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrDNSName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_cache_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"file_cache_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_cache_type_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lustre_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deployment_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDestination: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"level": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"metadata_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"storage_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"mount_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"per_unit_storage_throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weekly_maintenance_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrOwnerID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrSubnetIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFileCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &fsx.DescribeFileCachesInput{}
+
+	if v, ok := d.GetOk("file_cache_id"); ok {
+		input.FileCacheIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	filecaches, err := findFileCaches(ctx, conn, input, tfslices.PredicateTrue[*fsx.FileCache]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FSx for Lustre File Caches: %s", err)
+	}
+
+	// File cache descriptions don't include tags, so they are listed for each candidate.
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	filecacheTags := make(map[string]tftags.KeyValueTags)
+	var matches []*fsx.FileCache
+
+	for _, v := range filecaches {
+		tags, err := listTags(ctx, conn, aws.StringValue(v.ResourceARN))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for FSx for Lustre File Cache (%s): %s", aws.StringValue(v.FileCacheId), err)
+		}
+
+		if len(tagsToMatch) > 0 && !tags.ContainsAll(tagsToMatch) {
+			continue
+		}
+
+		filecacheTags[aws.StringValue(v.FileCacheId)] = tags
+		matches = append(matches, v)
+	}
+
+	filecache, err := tfresource.AssertSinglePtrResult(matches)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("FSx for Lustre File Cache", err))
+	}
+
+	d.SetId(aws.StringValue(filecache.FileCacheId))
+	d.Set(names.AttrARN, filecache.ResourceARN)
+	d.Set("data_repository_association_ids", aws.StringValueSlice(filecache.DataRepositoryAssociationIds))
+	d.Set(names.AttrDNSName, filecache.DNSName)
+	d.Set("file_cache_id", filecache.FileCacheId)
+	d.Set("file_cache_type", filecache.FileCacheType)
+	d.Set("file_cache_type_version", filecache.FileCacheTypeVersion)
+	d.Set(names.AttrKMSKeyID, filecache.KmsKeyId)
+	if err := d.Set("lustre_configuration", flattenFileCacheLustreConfiguration(filecache.LustreConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting lustre_configuration: %s", err)
+	}
+	d.Set("network_interface_ids", aws.StringValueSlice(filecache.NetworkInterfaceIds))
+	d.Set(names.AttrOwnerID, filecache.OwnerId)
+	d.Set("storage_capacity", filecache.StorageCapacity)
+	d.Set(names.AttrSubnetIDs, aws.StringValueSlice(filecache.SubnetIds))
+	d.Set(names.AttrVPCID, filecache.VpcId)
+
+	if err := d.Set(names.AttrTags, filecacheTags[d.Id()].IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
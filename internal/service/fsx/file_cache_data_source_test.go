@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFileCacheDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_fsx_file_cache.test"
+	resourceName := "aws_fsx_file_cache.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileCacheDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDNSName, resourceName, names.AttrDNSName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_cache_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_cache_type", resourceName, "file_cache_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_cache_type_version", resourceName, "file_cache_type_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrKMSKeyID, resourceName, names.AttrKMSKeyID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lustre_configuration.#", resourceName, "lustre_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lustre_configuration.0.deployment_type", resourceName, "lustre_configuration.0.deployment_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lustre_configuration.0.metadata_configuration.0.storage_capacity", resourceName, "lustre_configuration.0.metadata_configuration.0.storage_capacity"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lustre_configuration.0.weekly_maintenance_start_time", resourceName, "lustre_configuration.0.weekly_maintenance_start_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_capacity", resourceName, "storage_capacity"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTags, resourceName, names.AttrTags),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, resourceName, names.AttrVPCID),
+				),
+			},
+		},
+	})
+}
+
+func testAccFileCacheDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_fsx_file_cache.test"
+	resourceName := "aws_fsx_file_cache.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileCacheDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_cache_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccFileCacheDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFileCacheConfig_tags1(rName, "Name", rName), `
+data "aws_fsx_file_cache" "test" {
+  file_cache_id = aws_fsx_file_cache.test.id
+}
+`)
+}
+
+func testAccFileCacheDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccFileCacheConfig_tags1(rName, "Name", rName), `
+data "aws_fsx_file_cache" "test" {
+  tags = {
+    Name = aws_fsx_file_cache.test.tags["Name"]
+  }
+}
+`)
+}
@@ -35,6 +35,11 @@ func TestAccFSxFileCache_serial(t *testing.T) {
 			"data_repository_association_s3":            testAccFileCache_dataRepositoryAssociation_s3,
 			"security_group_id":                         testAccFileCache_securityGroupID,
 			"tags":                                      testAccFileCache_tags,
+			"weekly_maintenance_start_time":             testAccFileCache_weeklyMaintenanceStartTime,
+		},
+		"FSxFileCacheDataSource": {
+			acctest.CtBasic: testAccFileCacheDataSource_basic,
+			"tags":          testAccFileCacheDataSource_tags,
 		},
 	}
 
@@ -391,6 +396,50 @@ func testAccFileCache_tags(t *testing.T) {
 	})
 }
 
+func testAccFileCache_weeklyMaintenanceStartTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var filecache1, filecache2 fsx.FileCache
+	resourceName := "aws_fsx_file_cache.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileCacheConfig_weeklyMaintenanceStartTime(rName, "1:01:01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileCacheExists(ctx, resourceName, &filecache1),
+					resource.TestCheckResourceAttr(resourceName, "lustre_configuration.0.weekly_maintenance_start_time", "1:01:01"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_tags_to_data_repository_associations"},
+			},
+			{
+				Config: testAccFileCacheConfig_weeklyMaintenanceStartTime(rName, "2:02:02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileCacheExists(ctx, resourceName, &filecache2),
+					testAccCheckFileCacheNotRecreated(&filecache1, &filecache2),
+					resource.TestCheckResourceAttr(resourceName, "lustre_configuration.0.weekly_maintenance_start_time", "2:02:02"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFileCacheDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn(ctx)
@@ -483,6 +532,27 @@ resource "aws_fsx_file_cache" "test" {
 `)
 }
 
+func testAccFileCacheConfig_weeklyMaintenanceStartTime(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccFileCacheConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_file_cache" "test" {
+  file_cache_type         = "LUSTRE"
+  file_cache_type_version = "2.12"
+
+  lustre_configuration {
+    deployment_type = "CACHE_1"
+    metadata_configuration {
+      storage_capacity = 2400
+    }
+    per_unit_storage_throughput   = 1000
+    weekly_maintenance_start_time = %[1]q
+  }
+
+  subnet_ids       = [aws_subnet.test[0].id]
+  storage_capacity = 1200
+}
+`, startTime))
+}
+
 func testAccFileCacheConfig_nfs_association(rName string) string {
 	return acctest.ConfigCompose(testAccFileCacheConfig_base(rName), `
 resource "aws_fsx_file_cache" "test" {
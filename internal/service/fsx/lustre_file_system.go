@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -301,10 +303,22 @@ func resourceLustreFileSystem() *schema.Resource {
 			verify.SetTagsDiff,
 			resourceLustreFileSystemStorageCapacityCustomizeDiff,
 			resourceLustreFileSystemMetadataConfigCustomizeDiff,
+			resourceLustreFileSystemPerUnitStorageThroughputCustomizeDiff,
 		),
 	}
 }
 
+// lustrePerUnitStorageThroughputValues holds the supported per_unit_storage_throughput values by deployment type and storage type.
+var lustrePerUnitStorageThroughputValues = map[string]map[string][]int{
+	fsx.LustreDeploymentTypePersistent1: {
+		fsx.StorageTypeHdd: {12, 40},
+		fsx.StorageTypeSsd: {50, 100, 200},
+	},
+	fsx.LustreDeploymentTypePersistent2: {
+		fsx.StorageTypeSsd: {125, 250, 500, 1000},
+	},
+}
+
 func resourceLustreFileSystemStorageCapacityCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	// we want to force a new resource if the new storage capacity is less than the old one
 	if d.HasChange("storage_capacity") {
@@ -330,6 +344,17 @@ func resourceLustreFileSystemMetadataConfigCustomizeDiff(_ context.Context, d *s
 		}
 	}
 
+	// iops is managed by FSx in AUTOMATIC mode and can only be configured in USER_PROVISIONED mode.
+	if v := d.GetRawConfig().GetAttr("metadata_configuration"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if config := v.Index(cty.NumberIntVal(0)); config.IsKnown() && !config.IsNull() {
+			if mode, iops := config.GetAttr(names.AttrMode), config.GetAttr(names.AttrIOPS); mode.IsKnown() && !mode.IsNull() && !iops.IsNull() {
+				if mode.AsString() == fsx.MetadataConfigurationModeAutomatic {
+					return fmt.Errorf("metadata_configuration.0.iops cannot be set when mode is %s", fsx.MetadataConfigurationModeAutomatic)
+				}
+			}
+		}
+	}
+
 	// we want to force a new resource if the new Iops is less than the old one
 	if d.HasChange("metadata_configuration") {
 		if v, ok := d.GetOk("metadata_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -364,6 +389,29 @@ func resourceLustreFileSystemMetadataConfigCustomizeDiff(_ context.Context, d *s
 	return nil
 }
 
+func resourceLustreFileSystemPerUnitStorageThroughputCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("per_unit_storage_throughput") || !d.NewValueKnown("deployment_type") || !d.NewValueKnown(names.AttrStorageType) {
+		return nil
+	}
+
+	v, ok := d.GetOk("per_unit_storage_throughput")
+	if !ok {
+		return nil
+	}
+
+	deploymentType, storageType := d.Get("deployment_type").(string), d.Get(names.AttrStorageType).(string)
+	values, ok := lustrePerUnitStorageThroughputValues[deploymentType][storageType]
+	if !ok {
+		return fmt.Errorf("per_unit_storage_throughput cannot be set when deployment type is %s and storage type is %s", deploymentType, storageType)
+	}
+
+	if throughput := v.(int); !slices.Contains(values, throughput) {
+		return fmt.Errorf("per_unit_storage_throughput must be one of %v when deployment type is %s and storage type is %s, got: %d", values, deploymentType, storageType, throughput)
+	}
+
+	return nil
+}
+
 func resourceLustreFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
@@ -750,11 +798,13 @@ func expandLustreMetadataUpdateConfiguration(l []interface{}) *fsx.UpdateFileSys
 	}
 
 	data := l[0].(map[string]interface{})
+	mode := data[names.AttrMode].(string)
 	req := &fsx.UpdateFileSystemLustreMetadataConfiguration{
-		Mode: aws.String(data[names.AttrMode].(string)),
+		Mode: aws.String(mode),
 	}
 
-	if v, ok := data[names.AttrIOPS].(int); ok && v != 0 {
+	// When switching to AUTOMATIC mode the previously provisioned iops are still in state but must not be sent.
+	if v, ok := data[names.AttrIOPS].(int); ok && v != 0 && mode == fsx.MetadataConfigurationModeUserProvisioned {
 		req.Iops = aws.Int64(int64(v))
 	}
 
@@ -893,6 +893,67 @@ func TestAccFSxLustreFileSystem_metadataConfig_decrease(t *testing.T) {
 	})
 }
 
+func TestAccFSxLustreFileSystem_metadataConfig_automatic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLustreFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLustreFileSystemConfig_metadata_iops(rName, "AUTOMATIC", 1500),
+				ExpectError: regexache.MustCompile(`metadata_configuration.0.iops cannot be set when mode is AUTOMATIC`),
+			},
+			{
+				Config: testAccLustreFileSystemConfig_metadata_iops(rName, "USER_PROVISIONED", 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLustreFileSystemExists(ctx, resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.mode", "USER_PROVISIONED"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.iops", "1500"),
+				),
+			},
+			{
+				Config: testAccLustreFileSystemConfig_metadata(rName, "AUTOMATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLustreFileSystemExists(ctx, resourceName, &filesystem2),
+					testAccCheckLustreFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.mode", "AUTOMATIC"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.iops"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFSxLustreFileSystem_perUnitStorageThroughputValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLustreFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLustreFileSystemConfig_persistent1DeploymentType(rName, 125),
+				ExpectError: regexache.MustCompile(`per_unit_storage_throughput must be one of \[50 100 200\] when deployment type is PERSISTENT_1 and storage type is SSD`),
+			},
+			{
+				Config:      testAccLustreFileSystemConfig_persistent2DeploymentType(rName, 50),
+				ExpectError: regexache.MustCompile(`per_unit_storage_throughput must be one of \[125 250 500 1000\] when deployment type is PERSISTENT_2 and storage type is SSD`),
+			},
+		},
+	})
+}
+
 func TestAccFSxLustreFileSystem_rootSquashConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var filesystem fsx.FileSystem
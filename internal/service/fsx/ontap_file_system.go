@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -247,16 +246,7 @@ func resourceONTAPFileSystem() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: customdiff.All(
-			verify.SetTagsDiff,
-			customdiff.ForceNewIfChange("throughput_capacity_per_ha_pair", func(ctx context.Context, old, new, meta any) bool {
-				if new != nil && new != 0 {
-					return new.(int) != old.(int)
-				} else {
-					return false
-				}
-			}),
-		),
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -445,6 +435,10 @@ func resourceONTAPFileSystemUpdate(ctx context.Context, d *schema.ResourceData,
 			input.OntapConfiguration.ThroughputCapacity = aws.Int64(int64(d.Get("throughput_capacity").(int)))
 		}
 
+		if d.HasChange("throughput_capacity_per_ha_pair") {
+			input.OntapConfiguration.ThroughputCapacityPerHAPair = aws.Int64(int64(d.Get("throughput_capacity_per_ha_pair").(int)))
+		}
+
 		if d.HasChange("weekly_maintenance_start_time") {
 			input.OntapConfiguration.WeeklyMaintenanceStartTime = aws.String(d.Get("weekly_maintenance_start_time").(string))
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -98,8 +99,11 @@ func resourceAccelerator() *schema.Resource {
 			names.AttrIPAddresses: {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
 			},
 			"ip_sets": {
 				Type:     schema.TypeList,
@@ -132,7 +136,13 @@ func resourceAccelerator() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Static IP addresses can be changed in place, but once requested they can't be released.
+			customdiff.ForceNewIfChange(names.AttrIPAddresses, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
 	}
 }
 
@@ -229,7 +239,7 @@ func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
 
-	if d.HasChanges(names.AttrName, names.AttrIPAddressType, names.AttrEnabled) {
+	if d.HasChanges(names.AttrName, names.AttrIPAddressType, names.AttrIPAddresses, names.AttrEnabled) {
 		input := &globalaccelerator.UpdateAcceleratorInput{
 			AcceleratorArn: aws.String(d.Id()),
 			Enabled:        aws.Bool(d.Get(names.AttrEnabled).(bool)),
@@ -240,6 +250,13 @@ func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.IpAddressType = awstypes.IpAddressType(v.(string))
 		}
 
+		// The IP address type and any BYOIP addresses are validated together, so send both when either changes.
+		if d.HasChanges(names.AttrIPAddressType, names.AttrIPAddresses) {
+			if v, ok := d.GetOk(names.AttrIPAddresses); ok && len(v.([]interface{})) > 0 {
+				input.IpAddresses = flex.ExpandStringValueList(v.([]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateAccelerator(ctx, input)
 
 		if err != nil {
@@ -104,6 +104,39 @@ func TestAccGlobalAcceleratorAccelerator_ipAddressType_dualStack(t *testing.T) {
 	})
 }
 
+func TestAccGlobalAcceleratorAccelerator_ipAddressType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_accelerator.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAcceleratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcceleratorConfig_enabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcceleratorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dual_stack_dns_name", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIPAddressType, "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAcceleratorConfig_ipAddressTypeDualStack(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcceleratorExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dual_stack_dns_name"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIPAddressType, "DUAL_STACK"),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlobalAcceleratorAccelerator_byoip(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_globalaccelerator_accelerator.test"
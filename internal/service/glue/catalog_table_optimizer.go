@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glue_catalog_table_optimizer", name="Catalog Table Optimizer")
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCatalogTableOptimizerCreate,
+		ReadWithoutTimeout:   resourceCatalogTableOptimizerRead,
+		UpdateWithoutTimeout: resourceCatalogTableOptimizerUpdate,
+		DeleteWithoutTimeout: resourceCatalogTableOptimizerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrDatabaseName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTableName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(glue.TableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	catalogTableOptimizerResourceIDPartCount = 4
+)
+
+func resourceCatalogTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get(names.AttrDatabaseName).(string)
+	tableName := d.Get(names.AttrTableName).(string)
+	optimizerType := d.Get(names.AttrType).(string)
+	id := errs.Must(flex.FlattenResourceId([]string{catalogID, dbName, tableName, optimizerType}, catalogTableOptimizerResourceIDPartCount, false))
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	_, err := conn.CreateTableOptimizerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table Optimizer (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), catalogTableOptimizerResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	catalogID, dbName, tableName, optimizerType := parts[0], parts[1], parts[2], parts[3]
+	output, err := findTableOptimizer(ctx, conn, catalogID, dbName, tableName, optimizerType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCatalogID, output.CatalogId)
+	if err := d.Set(names.AttrConfiguration, flattenTableOptimizerConfiguration(output.TableOptimizer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrDatabaseName, output.DatabaseName)
+	d.Set(names.AttrTableName, output.TableName)
+	d.Set(names.AttrType, output.TableOptimizer.Type)
+
+	return diags
+}
+
+func resourceCatalogTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	input := &glue.UpdateTableOptimizerInput{
+		CatalogId:                   aws.String(d.Get(names.AttrCatalogID).(string)),
+		DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
+		TableName:                   aws.String(d.Get(names.AttrTableName).(string)),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(d.Get(names.AttrType).(string)),
+	}
+
+	_, err := conn.UpdateTableOptimizerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err := conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(d.Get(names.AttrCatalogID).(string)),
+		DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
+		TableName:    aws.String(d.Get(names.AttrTableName).(string)),
+		Type:         aws.String(d.Get(names.AttrType).(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findTableOptimizer(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName, optimizerType string) (*glue.GetTableOptimizerOutput, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandTableOptimizerConfiguration(tfList []interface{}) *glue.TableOptimizerConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &glue.TableOptimizerConfiguration{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+		names.AttrRoleARN: aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+// Exports for use in tests only.
+var (
+	FindTableOptimizer = findTableOptimizer
+)
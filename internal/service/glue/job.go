@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
@@ -90,6 +91,7 @@ func ResourceJob() *schema.Resource {
 			"execution_class": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(glue.ExecutionClass_Values(), true),
 			},
 			"execution_property": {
@@ -113,6 +115,17 @@ func ResourceJob() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"job_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(glue.JobMode_Values(), false),
+			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(Sun|Mon|Tue|Wed|Thu|Fri|Sat):([01]?\d|2[0-3])$`), "must be in the format DDD:HH, e.g. Sun:02"),
+			},
 			names.AttrMaxCapacity: {
 				Type:          schema.TypeFloat,
 				Optional:      true,
@@ -223,6 +236,14 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.GlueVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("job_mode"); ok {
+		input.JobMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrMaxCapacity); ok {
 		input.MaxCapacity = aws.Float64(v.(float64))
 	}
@@ -303,6 +324,8 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting execution_property: %s", err)
 	}
 	d.Set("glue_version", job.GlueVersion)
+	d.Set("job_mode", job.JobMode)
+	d.Set("maintenance_window", job.MaintenanceWindow)
 	d.Set(names.AttrMaxCapacity, job.MaxCapacity)
 	d.Set("max_retries", job.MaxRetries)
 	d.Set(names.AttrName, job.Name)
@@ -355,6 +378,14 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			jobUpdate.GlueVersion = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("job_mode"); ok {
+			jobUpdate.JobMode = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("maintenance_window"); ok {
+			jobUpdate.MaintenanceWindow = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("max_retries"); ok {
 			jobUpdate.MaxRetries = aws.Int64(int64(v.(int)))
 		}
@@ -32,14 +32,12 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
 						},
 						names.AttrStatus: {
@@ -123,7 +121,14 @@ func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	additionalConfigurations := feature.AdditionalConfiguration
+	// Only read back the additional configurations that are managed by this resource.
+	// GuardDuty returns all of a feature's additional configurations (e.g. ECS Fargate and EC2 agent management
+	// for RUNTIME_MONITORING) even when only some of them have been configured.
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		additionalConfigurations = filterDetectorAdditionalConfigurationResults(additionalConfigurations, v.([]interface{}))
+	}
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(additionalConfigurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("detector_id", detectorID)
@@ -164,6 +169,30 @@ func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardD
 	}))
 }
 
+// filterDetectorAdditionalConfigurationResults returns the API objects whose names are in the specified configuration, in configuration order.
+func filterDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult, tfList []interface{}) []*guardduty.DetectorAdditionalConfigurationResult {
+	var results []*guardduty.DetectorAdditionalConfigurationResult
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+
+		for _, apiObject := range apiObjects {
+			if apiObject != nil && aws.StringValue(apiObject.Name) == name {
+				results = append(results, apiObject)
+				break
+			}
+		}
+	}
+
+	return results
+}
+
 func expandDetectorAdditionalConfiguration(tfMap map[string]interface{}) *guardduty.DetectorAdditionalConfiguration {
 	if tfMap == nil {
 		return nil
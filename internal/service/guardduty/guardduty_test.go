@@ -29,9 +29,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_id":                     testAccDetectorDataSource_ID,
 		},
 		"DetectorFeature": {
-			acctest.CtBasic:            testAccDetectorFeature_basic,
-			"additional_configuration": testAccDetectorFeature_additionalConfiguration,
-			"multiple":                 testAccDetectorFeature_multiple,
+			acctest.CtBasic:                       testAccDetectorFeature_basic,
+			"additional_configuration":            testAccDetectorFeature_additionalConfiguration,
+			"multiple":                            testAccDetectorFeature_multiple,
+			"runtime_monitoring_agent_management": testAccDetectorFeature_runtimeMonitoringAgentManagement,
 		},
 		"Filter": {
 			acctest.CtBasic:      testAccFilter_basic,
@@ -64,6 +65,9 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"additional_configuration": testAccOrganizationConfigurationFeature_additionalConfiguration,
 			"multiple":                 testAccOrganizationConfigurationFeature_multiple,
 		},
+		"OrganizationStatistics": {
+			"datasource_basic": testAccOrganizationStatisticsDataSource_basic,
+		},
 		"ThreatIntelSet": {
 			acctest.CtBasic: testAccThreatIntelSet_basic,
 			"tags":          testAccThreatIntelSet_tags,
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Organization Statistics")
+func newDataSourceOrganizationStatistics(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceOrganizationStatistics{}, nil
+}
+
+const (
+	DSNameOrganizationStatistics = "Organization Statistics Data Source"
+)
+
+type dataSourceOrganizationStatistics struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*dataSourceOrganizationStatistics) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_guardduty_organization_statistics"
+}
+
+func (d *dataSourceOrganizationStatistics) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active_accounts_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"count_by_feature": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[organizationFeatureStatisticsModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[organizationFeatureStatisticsModel](ctx),
+			},
+			"enabled_accounts_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_accounts_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"total_accounts_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceOrganizationStatistics) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().GuardDutyClient(ctx)
+
+	var data dataSourceOrganizationStatisticsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findOrganizationDetails(ctx, conn)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.GuardDuty, create.ErrActionReading, DSNameOrganizationStatistics, d.Meta().AccountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if v := output.OrganizationStatistics; v != nil {
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, v, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().AccountID)
+	data.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.UpdatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findOrganizationDetails(ctx context.Context, conn *guardduty.Client) (*awstypes.OrganizationDetails, error) {
+	input := &guardduty.GetOrganizationStatisticsInput{}
+
+	output, err := conn.GetOrganizationStatistics(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OrganizationDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OrganizationDetails, nil
+}
+
+type dataSourceOrganizationStatisticsModel struct {
+	ActiveAccountsCount  types.Int64                                                         `tfsdk:"active_accounts_count"`
+	CountByFeature       fwtypes.ListNestedObjectValueOf[organizationFeatureStatisticsModel] `tfsdk:"count_by_feature"`
+	EnabledAccountsCount types.Int64                                                         `tfsdk:"enabled_accounts_count"`
+	ID                   types.String                                                        `tfsdk:"id"`
+	MemberAccountsCount  types.Int64                                                         `tfsdk:"member_accounts_count"`
+	TotalAccountsCount   types.Int64                                                         `tfsdk:"total_accounts_count"`
+	UpdatedAt            timetypes.RFC3339                                                   `tfsdk:"updated_at"`
+}
+
+type organizationFeatureStatisticsModel struct {
+	AdditionalConfiguration fwtypes.ListNestedObjectValueOf[organizationFeatureStatisticsAdditionalConfigurationModel] `tfsdk:"additional_configuration"`
+	EnabledAccountsCount    types.Int64                                                                                `tfsdk:"enabled_accounts_count"`
+	Name                    fwtypes.StringEnum[awstypes.OrgFeature]                                                    `tfsdk:"name"`
+}
+
+type organizationFeatureStatisticsAdditionalConfigurationModel struct {
+	EnabledAccountsCount types.Int64                                                    `tfsdk:"enabled_accounts_count"`
+	Name                 fwtypes.StringEnum[awstypes.OrgFeatureAdditionalConfiguration] `tfsdk:"name"`
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationStatisticsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_guardduty_organization_statistics.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationStatisticsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "active_accounts_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "count_by_feature.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "enabled_accounts_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member_accounts_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_accounts_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationStatisticsDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, `
+data "aws_guardduty_organization_statistics" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+}
+`)
+}
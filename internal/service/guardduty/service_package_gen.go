@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceFindingIds,
 			Name:    "Finding Ids",
 		},
+		{
+			Factory: newDataSourceOrganizationStatistics,
+			Name:    "Organization Statistics",
+		},
 	}
 }
 
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_healthlake_fhir_export_job", name="FHIR Export Job")
+func newFHIRExportJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirExportJobResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type fhirExportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*fhirExportJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_export_job"
+}
+
+func (r *fhirExportJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_data_config": outputDataConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirExportJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirExportJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	outputDataConfig, diags := expandOutputDataConfig(ctx, data.OutputDataConfig)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	datastoreID := data.DatastoreID.ValueString()
+	input := &healthlake.StartFHIRExportJobInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, data.DataAccessRoleARN),
+		DatastoreId:       aws.String(datastoreID),
+		JobName:           fwflex.StringFromFramework(ctx, data.JobName),
+		OutputDataConfig:  outputDataConfig,
+	}
+
+	output, err := conn.StartFHIRExportJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting HealthLake FHIR Export Job (%s)", datastoreID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	jobID := aws.ToString(output.JobId)
+	data.JobID = types.StringValue(jobID)
+
+	job, err := waitFHIRExportJobCompleted(ctx, conn, datastoreID, jobID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Export Job (%s/%s)", datastoreID, jobID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Status = fwtypes.StringEnumValue(job.JobStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fhirExportJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirExportJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	datastoreID, jobID := data.DatastoreID.ValueString(), data.JobID.ValueString()
+	output, err := findFHIRExportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Export Job (%s/%s)", datastoreID, jobID), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(output.JobStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFHIRExportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ExportJobProperties, error) {
+	input := &healthlake.DescribeFHIRExportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+
+	output, err := conn.DescribeFHIRExportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportJobProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportJobProperties, nil
+}
+
+func statusFHIRExportJob(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRExportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitFHIRExportJobCompleted(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string, timeout time.Duration) (*awstypes.ExportJobProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.JobStatusSubmitted, awstypes.JobStatusInProgress),
+		Target:       enum.Slice(awstypes.JobStatusCompleted, awstypes.JobStatusCompletedWithErrors),
+		Refresh:      statusFHIRExportJob(ctx, conn, datastoreID, jobID),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ExportJobProperties); ok {
+		if v := output.Message; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// outputDataConfigBlock returns the schema shared by the import job's `job_output_data_config` and the export job's `output_data_config`.
+func outputDataConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[outputDataConfigModel](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"s3_configuration": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrKMSKeyID: schema.StringAttribute{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+							},
+							"s3_uri": schema.StringAttribute{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandOutputDataConfig builds the S3 member of the OutputDataConfig union.
+func expandOutputDataConfig(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[outputDataConfigModel]) (awstypes.OutputDataConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	outputDataConfigData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	s3ConfigurationData, d := outputDataConfigData.S3Configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.OutputDataConfigMemberS3Configuration{}
+	diags.Append(fwflex.Expand(ctx, s3ConfigurationData, &apiObject.Value)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+type fhirExportJobResourceModel struct {
+	DataAccessRoleARN fwtypes.ARN                                            `tfsdk:"data_access_role_arn"`
+	DatastoreID       types.String                                           `tfsdk:"datastore_id"`
+	JobID             types.String                                           `tfsdk:"id"`
+	JobName           types.String                                           `tfsdk:"job_name"`
+	OutputDataConfig  fwtypes.ListNestedObjectValueOf[outputDataConfigModel] `tfsdk:"output_data_config"`
+	Status            fwtypes.StringEnum[awstypes.JobStatus]                 `tfsdk:"status"`
+	Timeouts          timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+type outputDataConfigModel struct {
+	S3Configuration fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3_configuration"`
+}
+
+type s3ConfigurationModel struct {
+	KmsKeyID types.String `tfsdk:"kms_key_id"`
+	S3URI    types.String `tfsdk:"s3_uri"`
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_healthlake_fhir_import_job", name="FHIR Import Job")
+func newFHIRImportJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirImportJobResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type fhirImportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*fhirImportJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_import_job"
+}
+
+func (r *fhirImportJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"input_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importJobInputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"job_output_data_config": outputDataConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirImportJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirImportJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	// We can't use AutoFlEx for the data configurations because the API structures use Go interfaces.
+	inputDataConfigData, diags := data.InputDataConfig.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outputDataConfig, diags := expandOutputDataConfig(ctx, data.JobOutputDataConfig)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	datastoreID := data.DatastoreID.ValueString()
+	input := &healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, data.DataAccessRoleARN),
+		DatastoreId:       aws.String(datastoreID),
+		InputDataConfig: &awstypes.InputDataConfigMemberS3Uri{
+			Value: inputDataConfigData.S3URI.ValueString(),
+		},
+		JobName:             fwflex.StringFromFramework(ctx, data.JobName),
+		JobOutputDataConfig: outputDataConfig,
+	}
+
+	output, err := conn.StartFHIRImportJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting HealthLake FHIR Import Job (%s)", datastoreID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	jobID := aws.ToString(output.JobId)
+	data.JobID = types.StringValue(jobID)
+
+	job, err := waitFHIRImportJobCompleted(ctx, conn, datastoreID, jobID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Import Job (%s/%s)", datastoreID, jobID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Status = fwtypes.StringEnumValue(job.JobStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fhirImportJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirImportJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	datastoreID, jobID := data.DatastoreID.ValueString(), data.JobID.ValueString()
+	output, err := findFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Import Job (%s/%s)", datastoreID, jobID), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(output.JobStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFHIRImportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ImportJobProperties, error) {
+	input := &healthlake.DescribeFHIRImportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+
+	output, err := conn.DescribeFHIRImportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportJobProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImportJobProperties, nil
+}
+
+func statusFHIRImportJob(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitFHIRImportJobCompleted(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string, timeout time.Duration) (*awstypes.ImportJobProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.JobStatusSubmitted, awstypes.JobStatusInProgress),
+		Target:       enum.Slice(awstypes.JobStatusCompleted, awstypes.JobStatusCompletedWithErrors),
+		Refresh:      statusFHIRImportJob(ctx, conn, datastoreID, jobID),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ImportJobProperties); ok {
+		if v := output.Message; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type fhirImportJobResourceModel struct {
+	DataAccessRoleARN   fwtypes.ARN                                                    `tfsdk:"data_access_role_arn"`
+	DatastoreID         types.String                                                   `tfsdk:"datastore_id"`
+	InputDataConfig     fwtypes.ListNestedObjectValueOf[importJobInputDataConfigModel] `tfsdk:"input_data_config"`
+	JobID               types.String                                                   `tfsdk:"id"`
+	JobName             types.String                                                   `tfsdk:"job_name"`
+	JobOutputDataConfig fwtypes.ListNestedObjectValueOf[outputDataConfigModel]         `tfsdk:"job_output_data_config"`
+	Status              fwtypes.StringEnum[awstypes.JobStatus]                         `tfsdk:"status"`
+	Timeouts            timeouts.Value                                                 `tfsdk:"timeouts"`
+}
+
+type importJobInputDataConfigModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ImportJobProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_import_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRImportJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRImportJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id", "aws_healthlake_fhir_datastore.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "job_output_data_config.0.s3_configuration.0.kms_key_id", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusCompleted)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRImportJobExists(ctx context.Context, n string, v *awstypes.ImportJobProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		output, err := tfhealthlake.FindFHIRImportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes["datastore_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFHIRJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "healthlake.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetBucketPublicAccessBlock",
+        "s3:GetEncryptionConfiguration",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+      }, {
+      Action = [
+        "kms:DescribeKey",
+        "kms:GenerateDataKey",
+      ]
+      Effect   = "Allow"
+      Resource = aws_kms_key.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccFHIRImportJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "input/patient.ndjson"
+
+  content = jsonencode({
+    resourceType = "Patient"
+    id           = "example"
+    name = [{
+      family = "Doe"
+      given  = ["Jane"]
+    }]
+  })
+}
+
+resource "aws_healthlake_fhir_import_job" "test" {
+  datastore_id         = aws_healthlake_fhir_datastore.test.id
+  data_access_role_arn = aws_iam_role.test.arn
+  job_name             = %[1]q
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/input/"
+  }
+
+  job_output_data_config {
+    s3_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      s3_uri     = "s3://${aws_s3_bucket.test.bucket}/import-output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName))
+}
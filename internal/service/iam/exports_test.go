@@ -31,23 +31,25 @@ var (
 	ResourceUserSSHKey                = resourceUserSSHKey
 	ResourceVirtualMFADevice          = resourceVirtualMFADevice
 
-	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
-	FindAccountPasswordPolicy           = findAccountPasswordPolicy
-	FindAttachedGroupPolicies           = findAttachedGroupPolicies
-	FindAttachedGroupPolicyByTwoPartKey = findAttachedGroupPolicyByTwoPartKey
-	FindAttachedRolePolicies            = findAttachedRolePolicies
-	FindAttachedRolePolicyByTwoPartKey  = findAttachedRolePolicyByTwoPartKey
-	FindAttachedUserPolicies            = findAttachedUserPolicies
-	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
-	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
-	FindGroupByName                     = findGroupByName
-	FindInstanceProfileByName           = findInstanceProfileByName
-	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
-	FindPolicyByARN                     = findPolicyByARN
-	FindSAMLProviderByARN               = findSAMLProviderByARN
-	FindServerCertificateByName         = findServerCertificateByName
-	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
-	FindUserByName                      = findUserByName
-	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
-	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
+	FindAccessKeyByTwoPartKey                 = findAccessKeyByTwoPartKey
+	FindAccountPasswordPolicy                 = findAccountPasswordPolicy
+	FindAttachedGroupPolicies                 = findAttachedGroupPolicies
+	FindAttachedGroupPolicyByTwoPartKey       = findAttachedGroupPolicyByTwoPartKey
+	FindAttachedRolePolicies                  = findAttachedRolePolicies
+	FindAttachedRolePolicyByTwoPartKey        = findAttachedRolePolicyByTwoPartKey
+	FindAttachedUserPolicies                  = findAttachedUserPolicies
+	FindAttachedUserPolicyByTwoPartKey        = findAttachedUserPolicyByTwoPartKey
+	FindEntitiesForPolicyByARN                = findEntitiesForPolicyByARN
+	FindGroupByName                           = findGroupByName
+	FindInstanceProfileByName                 = findInstanceProfileByName
+	FindOpenIDConnectProviderByARN            = findOpenIDConnectProviderByARN
+	FindOpenIDConnectProviderThumbprint       = findOpenIDConnectProviderThumbprint
+	FindPolicyByARN                           = findPolicyByARN
+	FindSAMLProviderByARN                     = findSAMLProviderByARN
+	FindServerCertificateByName               = findServerCertificateByName
+	FindSSHPublicKeyByThreePartKey            = findSSHPublicKeyByThreePartKey
+	FindUserByName                            = findUserByName
+	FindVirtualMFADeviceBySerialNumber        = findVirtualMFADeviceBySerialNumber
+	OpenIDConnectProviderThumbprintListUpdate = openIDConnectProviderThumbprintListUpdate
+	SESSMTPPasswordFromSecretKeySigV4         = sesSMTPPasswordFromSecretKeySigV4
 )
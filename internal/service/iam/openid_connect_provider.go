@@ -5,12 +5,21 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +52,11 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_thumbprint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"client_id_list": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -54,8 +68,11 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbprint_list": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"auto_thumbprint"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -70,7 +87,10 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceOpenIDConnectProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -78,11 +98,23 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
+	url := d.Get(names.AttrURL).(string)
 	input := &iam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
-		Tags:           getTagsIn(ctx),
-		ThumbprintList: flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
-		Url:            aws.String(d.Get(names.AttrURL).(string)),
+		ClientIDList: flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		Url:          aws.String(url),
+	}
+
+	if d.Get("auto_thumbprint").(bool) {
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), url)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM OIDC Provider: %s", err)
+		}
+
+		input.ThumbprintList = []string{thumbprint}
+	} else if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 {
+		input.ThumbprintList = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -197,6 +229,48 @@ func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.Resource
 	return append(diags, resourceOpenIDConnectProviderRead(ctx, d, meta)...)
 }
 
+func resourceOpenIDConnectProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Nothing to do on create; the thumbprint is resolved during apply.
+	if d.Id() == "" || !d.Get("auto_thumbprint").(bool) {
+		return nil
+	}
+
+	// A new URL replaces the provider, which resolves the thumbprint during apply.
+	if d.HasChange(names.AttrURL) {
+		return d.SetNewComputed("thumbprint_list")
+	}
+
+	// Resolve the thumbprint on every plan so that a rotated certificate chain is picked up.
+	o, _ := d.GetChange("thumbprint_list")
+	thumbprintList, err := openIDConnectProviderThumbprintListUpdate(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), d.Get(names.AttrURL).(string), o.([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	if thumbprintList == nil {
+		return nil
+	}
+
+	return d.SetNew("thumbprint_list", thumbprintList)
+}
+
+// openIDConnectProviderThumbprintListUpdate resolves the specified OIDC provider's current thumbprint.
+// It returns the new thumbprint list, or nil if the current thumbprint list already consists of that thumbprint.
+func openIDConnectProviderThumbprintListUpdate(ctx context.Context, client *http.Client, issuerURL string, thumbprintList []interface{}) ([]string, error) {
+	thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, client, issuerURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(thumbprintList) == 1 && thumbprintList[0] == thumbprint {
+		return nil, nil
+	}
+
+	return []string{thumbprint}, nil
+}
+
 func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
@@ -241,6 +315,77 @@ func findOpenIDConnectProviderByARN(ctx context.Context, conn *iam.Client, arn s
 	return output, nil
 }
 
+// findOpenIDConnectProviderThumbprint returns the thumbprint of the top intermediate certificate authority (CA)
+// of the certificate chain presented by the server hosting the specified OIDC provider's JSON Web Key Set.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func findOpenIDConnectProviderThumbprint(ctx context.Context, client *http.Client, issuerURL string) (string, error) {
+	if !strings.Contains(issuerURL, "://") {
+		issuerURL = "https://" + issuerURL
+	}
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+
+	_, body, err := httpGetOpenIDConnectProviderDocument(ctx, client, configurationURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return "", fmt.Errorf("decoding OIDC discovery document (%s): %w", configurationURL, err)
+	}
+
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document (%s) does not contain jwks_uri", configurationURL)
+	}
+
+	state, _, err := httpGetOpenIDConnectProviderDocument(ctx, client, configuration.JWKSURI)
+
+	if err != nil {
+		return "", err
+	}
+
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return "", fmt.Errorf("no TLS certificates presented by %s", configuration.JWKSURI)
+	}
+
+	certificate := state.PeerCertificates[len(state.PeerCertificates)-1]
+	thumbprint := sha1.Sum(certificate.Raw)
+
+	return hex.EncodeToString(thumbprint[:]), nil
+}
+
+func httpGetOpenIDConnectProviderDocument(ctx context.Context, client *http.Client, url string) (*tls.ConnectionState, []byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating HTTP request (%s): %w", url, err)
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("HTTP GET (%s): %w", url, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("HTTP GET (%s): unexpected status %s", url, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading HTTP response body (%s): %w", url, err)
+	}
+
+	return response.TLS, body, nil
+}
+
 func openIDConnectProviderTags(ctx context.Context, conn *iam.Client, identifier string) ([]awstypes.Tag, error) {
 	output, err := conn.ListOpenIDConnectProviderTags(ctx, &iam.ListOpenIDConnectProviderTagsInput{
 		OpenIDConnectProviderArn: aws.String(identifier),
@@ -5,7 +5,12 @@ package iam_test
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -18,6 +23,111 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"issuer":%[1]q,"jwks_uri":"%[1]s/keys"}`, server.URL)
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys":[]}`)
+	})
+
+	sum := sha1.Sum(server.Certificate().Raw)
+	want := hex.EncodeToString(sum[:])
+
+	got, err := tfiam.FindOpenIDConnectProviderThumbprint(ctx, server.Client(), server.URL+"/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != want {
+		t.Errorf("got thumbprint %s, want %s", got, want)
+	}
+}
+
+func TestOpenIDConnectProviderThumbprintListUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"issuer":%[1]q,"jwks_uri":"%[1]s/keys"}`, server.URL)
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys":[]}`)
+	})
+
+	sum := sha1.Sum(server.Certificate().Raw)
+	thumbprint := hex.EncodeToString(sum[:])
+	rotated := "0000000000000000000000000000000000000000"
+
+	testCases := map[string]struct {
+		url            string
+		thumbprintList []interface{}
+		want           []string
+		wantErr        bool
+	}{
+		"unchanged": {
+			url:            server.URL,
+			thumbprintList: []interface{}{thumbprint},
+		},
+		"rotated": {
+			url:            server.URL,
+			thumbprintList: []interface{}{rotated},
+			want:           []string{thumbprint},
+		},
+		"multiple": {
+			url:            server.URL,
+			thumbprintList: []interface{}{thumbprint, rotated},
+			want:           []string{thumbprint},
+		},
+		"empty": {
+			url:  server.URL,
+			want: []string{thumbprint},
+		},
+		"unreachable": {
+			url:            server.URL + "/missing",
+			thumbprintList: []interface{}{thumbprint},
+			wantErr:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfiam.OpenIDConnectProviderThumbprintListUpdate(ctx, server.Client(), testCase.url, testCase.thumbprintList)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got thumbprint list %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccIAMOpenIDConnectProvider_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rString := sdkacctest.RandString(5)
@@ -167,6 +277,58 @@ func TestAccIAMOpenIDConnectProvider_clientIDModification(t *testing.T) {
 	})
 }
 
+// The thumbprint is resolved from a live OIDC issuer. An issuer URL can only be registered once per account,
+// so this test doesn't run in parallel and is skipped if the account already has a provider for the issuer.
+func TestAccIAMOpenIDConnectProvider_autoThumbprint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckOpenIDConnectProviderNotExists(ctx, t, "token.actions.githubusercontent.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_autoThumbprint(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_thumbprint", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_thumbprint"},
+			},
+		},
+	})
+}
+
+func testAccPreCheckOpenIDConnectProviderNotExists(ctx context.Context, t *testing.T, issuer string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+	arn := fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", acctest.Partition(), acctest.AccountID(), issuer)
+
+	_, err := tfiam.FindOpenIDConnectProviderByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("reading IAM OIDC Provider (%s): %s", arn, err)
+	}
+
+	t.Skipf("skipping acceptance test: IAM OIDC Provider (%s) already exists", arn)
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -304,3 +466,15 @@ resource "aws_iam_openid_connect_provider" "test" {
 }
 `, rName)
 }
+
+func testAccOpenIDConnectProviderConfig_autoThumbprint() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = ["sts.amazonaws.com"]
+
+  auto_thumbprint = true
+}
+`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMembershipsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_identitystore_group_memberships.test"
+	groupResourceName := "aws_identitystore_group.test"
+	membershipResourceName := "aws_identitystore_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsDataSourceConfig_basic(groupName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "group_memberships.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_memberships.0.group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_memberships.0.member_id", membershipResourceName, "member_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_memberships.0.membership_id", membershipResourceName, "membership_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipsDataSourceConfig_basic(groupName, userName string) string {
+	return acctest.ConfigCompose(testAccGroupMembershipConfig_basic(groupName, userName), `
+data "aws_identitystore_group_memberships" "test" {
+  depends_on = [aws_identitystore_group_membership.test]
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+}
+`)
+}
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCISScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.time_of_day", "12:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.timezone", "Etc/UTC"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.monthly.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.one_time", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.account_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "targets.0.account_ids.*", "SELF"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "targets.0.target_resource_tags.*", map[string]string{
+						names.AttrKey: "Environment",
+						"values.#":    acctest.Ct1,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCISScanConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_1"),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_weekly(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.days.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", "MON"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", "THU"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.start_time.0.time_of_day", "23:30"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_2"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_oneTime(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.one_time", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCISScanConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCISScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_cis_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Inspector CIS Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCISScanConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    daily {
+      start_time {
+        time_of_day = "12:00"
+        timezone    = "Etc/UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["production"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_weekly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_2"
+
+  schedule {
+    weekly {
+      days = ["MON", "THU"]
+
+      start_time {
+        time_of_day = "23:30"
+        timezone    = "Etc/UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["production", "staging"]
+    }
+
+    target_resource_tags {
+      key    = "Team"
+      values = ["security"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_oneTime(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_2"
+
+  schedule {
+    one_time = true
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["production"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time = true
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["production"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCISScanConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time = true
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["production"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -5,6 +5,10 @@ package inspector2
 
 // Exports for use in tests only.
 var (
+	ResourceCISScanConfiguration = resourceCISScanConfiguration
+
 	EnablerID      = enablerID
 	ParseEnablerID = parseEnablerID
+
+	FindCISScanConfigurationByARN = findCISScanConfigurationByARN
 )
@@ -13,6 +13,12 @@ func TestAccInspector2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"CISScanConfiguration": {
+			acctest.CtBasic:      testAccCISScanConfiguration_basic,
+			acctest.CtDisappears: testAccCISScanConfiguration_disappears,
+			"tags":               testAccCISScanConfiguration_tags,
+			"update":             testAccCISScanConfiguration_update,
+		},
 		"Enabler": {
 			acctest.CtBasic:                      testAccEnabler_basic,
 			"accountID":                          testAccEnabler_accountID,
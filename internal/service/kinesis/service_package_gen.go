@@ -36,6 +36,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_kinesis_stream_consumer",
 			Name:     "Stream Consumer",
 		},
+		{
+			Factory:  dataSourceStreamConsumers,
+			TypeName: "aws_kinesis_stream_consumers",
+			Name:     "Stream Consumers",
+		},
 	}
 }
 
@@ -88,6 +88,10 @@ func resourceStream() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"consumer_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"encryption_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -265,6 +269,7 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.Set(names.AttrARN, stream.StreamARN)
+	d.Set("consumer_count", stream.ConsumerCount)
 	d.Set("encryption_type", stream.EncryptionType)
 	d.Set(names.AttrKMSKeyID, stream.KeyId)
 	d.Set(names.AttrName, stream.StreamName)
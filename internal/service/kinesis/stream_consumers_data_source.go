@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kinesis_stream_consumers", name="Stream Consumers")
+func dataSourceStreamConsumers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStreamConsumersRead,
+
+		Schema: map[string]*schema.Schema{
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceStreamConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamARN),
+	}
+
+	consumers, err := findStreamConsumers(ctx, conn, input, tfslices.PredicateTrue[*types.Consumer]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream (%s) consumers: %s", streamARN, err)
+	}
+
+	d.SetId(streamARN)
+	if err := d.Set("consumers", flattenConsumers(consumers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting consumers: %s", err)
+	}
+	d.Set(names.AttrStreamARN, streamARN)
+
+	return diags
+}
+
+func flattenConsumers(apiObjects []types.Consumer) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:        aws.ToString(apiObject.ConsumerARN),
+			"creation_timestamp": aws.ToTime(apiObject.ConsumerCreationTimestamp).Format(time.RFC3339),
+			names.AttrName:       aws.ToString(apiObject.ConsumerName),
+			names.AttrStatus:     apiObject.ConsumerStatus,
+		})
+	}
+
+	return tfList
+}
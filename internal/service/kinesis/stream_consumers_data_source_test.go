@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisStreamConsumersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+	streamName := "aws_kinesis_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "consumers.*.arn", "aws_kinesis_stream_consumer.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "consumers.*.arn", "aws_kinesis_stream_consumer.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "consumers.*.name", "aws_kinesis_stream_consumer.test.0", names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "consumers.0.creation_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "consumers.0.status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStreamARN, streamName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccStreamConsumersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStreamConsumerDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+
+  depends_on = [aws_kinesis_stream_consumer.test]
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
@@ -32,6 +32,10 @@ func DataSourceStream() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"consumer_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"creation_timestamp": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -114,6 +118,7 @@ func dataSourceStreamRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(aws.ToString(stream.StreamARN))
 	d.Set(names.AttrARN, stream.StreamARN)
 	d.Set("closed_shards", aws.ToStringSlice(closedShards))
+	d.Set("consumer_count", stream.ConsumerCount)
 	d.Set("creation_timestamp", aws.ToTime(stream.StreamCreationTimestamp).Unix())
 	d.Set(names.AttrName, stream.StreamName)
 	d.Set("open_shards", aws.ToStringSlice(openShards))
@@ -30,6 +30,7 @@ func TestAccKinesisStreamDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrARN),
 					resource.TestCheckResourceAttrSet(dataSourceName, "creation_timestamp"),
 					resource.TestCheckResourceAttr(dataSourceName, "closed_shards.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "consumer_count", acctest.Ct0),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(dataSourceName, "open_shards.#", acctest.Ct2),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrRetentionPeriod, "72"),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,14 @@ func ResourceApplication() *schema.Resource {
 				// An existing input configuration cannot be deleted.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ForceNewIfChange("runtime_environment", func(_ context.Context, old, new, meta interface{}) bool {
+				// In-place runtime upgrades are only supported between Flink runtimes, and only to a newer version.
+				if old.(string) == kinesisanalyticsv2.RuntimeEnvironmentSql10 || new.(string) == kinesisanalyticsv2.RuntimeEnvironmentSql10 {
+					return true
+				}
+
+				return isFlinkRuntimeDowngrade(old.(string), new.(string))
+			}),
 		),
 
 		Importer: &schema.ResourceImporter{
@@ -911,7 +920,6 @@ func ResourceApplication() *schema.Resource {
 			"runtime_environment": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RuntimeEnvironment_Values(), false),
 			},
 
@@ -1028,7 +1036,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).KinesisAnalyticsV2Conn(ctx)
 	applicationName := d.Get(names.AttrName).(string)
 
-	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "service_execution_role") {
+	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "runtime_environment", "service_execution_role") {
 		currentApplicationVersionId := int64(d.Get("version_id").(int))
 		updateApplication := false
 
@@ -1475,6 +1483,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 		}
 
+		if d.HasChange("runtime_environment") {
+			input.RuntimeEnvironmentUpdate = aws.String(d.Get("runtime_environment").(string))
+
+			updateApplication = true
+		}
+
 		if d.HasChange("service_execution_role") {
 			input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
 
@@ -2961,3 +2975,43 @@ func expandStopApplicationInput(d *schema.ResourceData) *kinesisanalyticsv2.Stop
 
 	return apiObject
 }
+
+// isFlinkRuntimeDowngrade returns whether changing between the specified Flink runtime environments,
+// e.g. "FLINK-1_18" to "FLINK-1_15", moves to an older Flink version.
+func isFlinkRuntimeDowngrade(old, new string) bool {
+	oldMajor, oldMinor, ok := parseFlinkRuntimeVersion(old)
+	if !ok {
+		return false
+	}
+
+	newMajor, newMinor, ok := parseFlinkRuntimeVersion(new)
+	if !ok {
+		return false
+	}
+
+	return newMajor < oldMajor || (newMajor == oldMajor && newMinor < oldMinor)
+}
+
+func parseFlinkRuntimeVersion(runtimeEnvironment string) (int, int, bool) {
+	version, ok := strings.CutPrefix(runtimeEnvironment, "FLINK-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	majorPart, minorPart, ok := strings.Cut(version, "_")
+	if !ok {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(majorPart)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	minor, err := strconv.Atoi(minorPart)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
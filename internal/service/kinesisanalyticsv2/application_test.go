@@ -20,6 +20,36 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsFlinkRuntimeDowngrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "FLINK-1_15", new: "FLINK-1_18"},
+		{old: "FLINK-1_18", new: "FLINK-1_15", expected: true},
+		{old: "FLINK-1_8", new: "FLINK-1_11"},
+		{old: "FLINK-1_11", new: "FLINK-1_8", expected: true},
+		{old: "FLINK-1_18", new: "FLINK-1_18"},
+		{old: "SQL-1_0", new: "FLINK-1_18"},
+		{old: "FLINK-1_18", new: "SQL-1_0"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.old+" to "+testCase.new, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfkinesisanalyticsv2.IsFlinkRuntimeDowngrade(testCase.old, testCase.new), testCase.expected; got != want {
+				t.Errorf("IsFlinkRuntimeDowngrade = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v kinesisanalyticsv2.ApplicationDetail
@@ -114,7 +144,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct2),
 				),
 			},
 			{
@@ -156,7 +186,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct3),
 				),
 			},
 			{
@@ -198,7 +228,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct4),
 				),
 			},
 			{
@@ -240,7 +270,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "5"),
 				),
 			},
 			{
@@ -282,7 +312,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "6"),
 				),
 			},
 			{
@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -156,7 +155,7 @@ func updateTags(ctx context.Context, conn *kms.Client, identifier string, oldTag
 	}
 
 	if len(removedTags) > 0 || len(updatedTags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, identifier, newTags, optFns...); err != nil {
+		if err := waitTagsPropagated(ctx, conn, identifier, oldTags, newTags, optFns...); err != nil {
 			return fmt.Errorf("waiting for resource (%s) tag propagation: %w", identifier, err)
 		}
 	}
@@ -173,33 +172,15 @@ func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier st
 // waitTagsPropagated waits for kms service tags to be propagated.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func waitTagsPropagated(ctx context.Context, conn *kms.Client, id string, tags tftags.KeyValueTags, optFns ...func(*kms.Options)) error {
-	tflog.Debug(ctx, "Waiting for tag propagation", map[string]any{
-		names.AttrTags: tags,
-	})
-
-	checkFunc := func() (bool, error) {
-		output, err := listTags(ctx, conn, id, optFns...)
-
-		if tfresource.NotFound(err) {
-			return false, nil
-		}
-
-		if err != nil {
-			return false, err
-		}
-
-		if inContext, ok := tftags.FromContext(ctx); ok {
-			tags = tags.IgnoreConfig(inContext.IgnoreConfig)
-			output = output.IgnoreConfig(inContext.IgnoreConfig)
-		}
-
-		return output.Equal(tags), nil
-	}
-	opts := tfresource.WaitOpts{
+func waitTagsPropagated(ctx context.Context, conn *kms.Client, id string, oldTags, newTags tftags.KeyValueTags, optFns ...func(*kms.Options)) error {
+	config := tftags.PropagationConfig{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                1 * time.Second,
+		Timeout:                   10 * time.Minute,
+		ExactMatch:                true,
 	}
 
-	return tfresource.WaitUntil(ctx, 10*time.Minute, checkFunc, opts)
+	return tftags.WaitPropagated(ctx, oldTags, newTags, func(ctx context.Context) (tftags.KeyValueTags, error) {
+		return listTags(ctx, conn, id, optFns...)
+	}, config)
 }
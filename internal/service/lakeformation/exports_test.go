@@ -8,6 +8,10 @@ var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
+	BatchPermissionsRevocations              = batchPermissionsRevocations
+	FlattenBatchPermissionsEntryGrantOptions = flattenBatchPermissionsEntryGrantOptions
+
 	FindDataCellsFilterByID = findDataCellsFilterByID
+	FindPermissions         = findPermissions
 	FindResourceLFTagByID   = findResourceLFTagByID
 )
@@ -41,6 +41,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"lfTagPolicy":         testAccPermissions_lfTagPolicy,
 			"lfTagPolicyMultiple": testAccPermissions_lfTagPolicyMultiple,
 		},
+		"PermissionsBatch": {
+			acctest.CtBasic:      testAccPermissionsBatch_basic,
+			acctest.CtDisappears: testAccPermissionsBatch_disappears,
+		},
 		"PermissionsDataSource": {
 			acctest.CtBasic:    testAccPermissionsDataSource_basic,
 			"dataCellsFilter":  testAccPermissionsDataSource_dataCellsFilter,
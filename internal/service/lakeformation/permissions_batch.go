@@ -0,0 +1,713 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchGrantPermissions and BatchRevokePermissions accept at most 20 entries per request.
+	permissionsBatchMaxEntries = 20
+)
+
+// @SDKResource("aws_lakeformation_permissions_batch", name="Permissions Batch")
+func ResourcePermissionsBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionsBatchCreate,
+		ReadWithoutTimeout:   resourcePermissionsBatchRead,
+		UpdateWithoutTimeout: resourcePermissionsBatchUpdate,
+		DeleteWithoutTimeout: resourcePermissionsBatchDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_resource": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						names.AttrDatabase: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrPermissions: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						names.AttrPrincipal: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"table_with_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionsBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	entries := expandBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	for _, entry := range entries {
+		if n := countBatchPermissionsResources(entry.Resource); n != 1 {
+			return sdkdiag.AppendErrorf(diags, "creating Lake Formation Permissions Batch: entry for principal (%s) must specify exactly one resource, got %d", aws.ToString(entry.Principal.DataLakePrincipalIdentifier), n)
+		}
+	}
+
+	var catalogID *string
+	if v, ok := d.GetOk(names.AttrCatalogID); ok {
+		catalogID = aws.String(v.(string))
+	}
+
+	if err := grantBatchPermissions(ctx, conn, catalogID, entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Permissions Batch: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(prettify(entries))))
+
+	return append(diags, resourcePermissionsBatchRead(ctx, d, meta)...)
+}
+
+func resourcePermissionsBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	input := &lakeformation.ListPermissionsInput{}
+
+	if v, ok := d.GetOk(names.AttrCatalogID); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	// A single unfiltered listing keeps the number of API calls independent of the number of entries.
+	grants, err := findPermissions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	var tfList []interface{}
+	for _, tfMapRaw := range d.Get("entry").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entry := expandBatchPermissionsRequestEntry(tfMap)
+		permissions, permissionsWithGrantOption := batchPermissionsEntryGrants(entry, grants)
+
+		if batchPermissionsEntryGranted(entry, permissions) {
+			tfMap["permissions_with_grant_option"] = flex.FlattenStringyValueSet(flattenBatchPermissionsEntryGrantOptions(entry, permissionsWithGrantOption))
+			tfList = append(tfList, tfMap)
+		} else {
+			log.Printf("[WARN] Lake Formation Permissions Batch (%s) entry for principal (%s) not found", d.Id(), tfMap[names.AttrPrincipal])
+		}
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions Batch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("entry", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePermissionsBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	var catalogID *string
+	if v, ok := d.GetOk(names.AttrCatalogID); ok {
+		catalogID = aws.String(v.(string))
+	}
+
+	o, n := d.GetChange("entry")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add := expandBatchPermissionsRequestEntries(ns.Difference(os).List())
+	del := batchPermissionsRevocations(expandBatchPermissionsRequestEntries(os.Difference(ns).List()), add)
+
+	for _, entry := range add {
+		if n := countBatchPermissionsResources(entry.Resource); n != 1 {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): entry for principal (%s) must specify exactly one resource, got %d", d.Id(), aws.ToString(entry.Principal.DataLakePrincipalIdentifier), n)
+		}
+	}
+
+	// Grant before revoking so that a principal never loses access that it keeps in the new configuration.
+	if err := grantBatchPermissions(ctx, conn, catalogID, add); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	if err := revokeBatchPermissions(ctx, conn, catalogID, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionsBatchRead(ctx, d, meta)...)
+}
+
+func resourcePermissionsBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	var catalogID *string
+	if v, ok := d.GetOk(names.AttrCatalogID); ok {
+		catalogID = aws.String(v.(string))
+	}
+
+	entries := expandBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	log.Printf("[INFO] Deleting Lake Formation Permissions Batch: %s", d.Id())
+	if err := revokeBatchPermissions(ctx, conn, catalogID, entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// grantBatchPermissions grants the specified entries in chunks.
+// If a chunk fails, the chunks already granted are revoked, as they are not recorded in state.
+func grantBatchPermissions(ctx context.Context, conn *lakeformation.Client, catalogID *string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	var granted []awstypes.BatchPermissionsRequestEntry
+
+	for _, chunk := range tfslices.Chunks(entries, permissionsBatchMaxEntries) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			CatalogId: catalogID,
+			Entries:   chunk,
+		}
+
+		var output *lakeformation.BatchGrantPermissionsOutput
+		err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+			var err error
+			output, err = conn.BatchGrantPermissions(ctx, input)
+			if err != nil {
+				if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+					return retry.RetryableError(err)
+				}
+				if errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "is not authorized to access requested permissions") {
+					return retry.RetryableError(err)
+				}
+
+				return retry.NonRetryableError(err)
+			}
+
+			// Newly created principals and registered locations may not be visible to Lake Formation yet.
+			if err := batchPermissionsFailuresError(output.Failures); err != nil {
+				if retryableBatchPermissionsFailures(output.Failures) {
+					return retry.RetryableError(err)
+				}
+
+				return retry.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			output, err = conn.BatchGrantPermissions(ctx, input)
+			if err == nil {
+				err = batchPermissionsFailuresError(output.Failures)
+			}
+		}
+
+		if err != nil {
+			if revokeErr := revokeBatchPermissions(ctx, conn, catalogID, granted); revokeErr != nil {
+				return errors.Join(err, fmt.Errorf("revoking permissions granted before failure: %w", revokeErr))
+			}
+
+			return err
+		}
+
+		granted = append(granted, chunk...)
+	}
+
+	return nil
+}
+
+// revokeBatchPermissions revokes the specified entries in chunks.
+// Permissions that have already been revoked (or whose resource no longer exists) are not an error.
+func revokeBatchPermissions(ctx context.Context, conn *lakeformation.Client, catalogID *string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, permissionsBatchMaxEntries) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			CatalogId: catalogID,
+			Entries:   chunk,
+		}
+
+		var output *lakeformation.BatchRevokePermissionsOutput
+		err := retry.RetryContext(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
+			var err error
+			output, err = conn.BatchRevokePermissions(ctx, input)
+			if err != nil {
+				if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+					return retry.RetryableError(err)
+				}
+				if errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "is not authorized to access requested permissions") {
+					return retry.RetryableError(err)
+				}
+
+				return retry.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			output, err = conn.BatchRevokePermissions(ctx, input)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		failures := tfslices.Filter(output.Failures, func(v awstypes.BatchPermissionsFailureEntry) bool {
+			if v.Error == nil {
+				return true
+			}
+
+			message := aws.ToString(v.Error.ErrorMessage)
+
+			return !strings.Contains(message, "No permissions revoked") && !strings.Contains(message, "non-existent") && aws.ToString(v.Error.ErrorCode) != "EntityNotFoundException"
+		})
+
+		if err := batchPermissionsFailuresError(failures); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// batchPermissionsRevocations returns the removed entries to revoke, less any permissions that an added entry
+// grants to the same principal on the same resource. An entry whose permissions change appears as both.
+func batchPermissionsRevocations(removed, added []awstypes.BatchPermissionsRequestEntry) []awstypes.BatchPermissionsRequestEntry {
+	key := func(v awstypes.BatchPermissionsRequestEntry) string {
+		return aws.ToString(v.Principal.DataLakePrincipalIdentifier) + "\n" + prettify(v.Resource)
+	}
+
+	kept := make(map[string]awstypes.BatchPermissionsRequestEntry)
+	for _, v := range added {
+		k := key(v)
+		if w, ok := kept[k]; ok {
+			v.Permissions = append(v.Permissions, w.Permissions...)
+			v.PermissionsWithGrantOption = append(v.PermissionsWithGrantOption, w.PermissionsWithGrantOption...)
+		}
+		kept[k] = v
+	}
+
+	var apiObjects []awstypes.BatchPermissionsRequestEntry
+	for _, v := range removed {
+		if w, ok := kept[key(v)]; ok {
+			v.Permissions = tfslices.Filter(v.Permissions, func(p awstypes.Permission) bool {
+				return !slices.Contains(w.Permissions, p)
+			})
+			v.PermissionsWithGrantOption = tfslices.Filter(v.PermissionsWithGrantOption, func(p awstypes.Permission) bool {
+				return !slices.Contains(w.PermissionsWithGrantOption, p)
+			})
+		}
+
+		if len(v.Permissions) == 0 && len(v.PermissionsWithGrantOption) == 0 {
+			continue
+		}
+
+		apiObjects = append(apiObjects, v)
+	}
+
+	return apiObjects
+}
+
+func findPermissions(ctx context.Context, conn *lakeformation.Client, input *lakeformation.ListPermissionsInput) ([]awstypes.PrincipalResourcePermissions, error) {
+	var output []awstypes.PrincipalResourcePermissions
+
+	pages := lakeformation.NewListPermissionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PrincipalResourcePermissions...)
+	}
+
+	return output, nil
+}
+
+func batchPermissionsFailuresError(apiObjects []awstypes.BatchPermissionsFailureEntry) error {
+	var failureErrs []error
+
+	for _, apiObject := range apiObjects {
+		var principal string
+		if v := apiObject.RequestEntry; v != nil && v.Principal != nil {
+			principal = aws.ToString(v.Principal.DataLakePrincipalIdentifier)
+		}
+
+		if v := apiObject.Error; v != nil {
+			failureErrs = append(failureErrs, fmt.Errorf("principal (%s): %s: %s", principal, aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+		} else {
+			failureErrs = append(failureErrs, fmt.Errorf("principal (%s): unknown error", principal))
+		}
+	}
+
+	return errors.Join(failureErrs...)
+}
+
+func retryableBatchPermissionsFailures(apiObjects []awstypes.BatchPermissionsFailureEntry) bool {
+	for _, apiObject := range apiObjects {
+		if apiObject.Error == nil {
+			return false
+		}
+
+		message := aws.ToString(apiObject.Error.ErrorMessage)
+
+		if !strings.Contains(message, "Invalid principal") && !strings.Contains(message, "Grantee has no permissions") && !strings.Contains(message, "register the S3 path") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// batchPermissionsEntryGrants returns the permissions, and the permissions with grant option, that the listed grants
+// give an entry's principal on the entry's resource.
+// As with aws_lakeformation_permissions, table permissions may be returned as table with columns permissions.
+func batchPermissionsEntryGrants(entry awstypes.BatchPermissionsRequestEntry, grants []awstypes.PrincipalResourcePermissions) ([]awstypes.Permission, []awstypes.Permission) {
+	if entry.Principal == nil || entry.Resource == nil {
+		return nil, nil
+	}
+
+	principal := aws.ToString(entry.Principal.DataLakePrincipalIdentifier)
+
+	var permissions, permissionsWithGrantOption []awstypes.Permission
+	for _, grant := range grants {
+		if grant.Principal == nil || aws.ToString(grant.Principal.DataLakePrincipalIdentifier) != principal {
+			continue
+		}
+
+		if !batchPermissionsResourceMatches(entry.Resource, grant.Resource) {
+			continue
+		}
+
+		permissions = append(permissions, grant.Permissions...)
+		permissionsWithGrantOption = append(permissionsWithGrantOption, grant.PermissionsWithGrantOption...)
+	}
+
+	return permissions, permissionsWithGrantOption
+}
+
+// batchPermissionsEntryGranted reports whether all of an entry's permissions are present in the granted permissions.
+func batchPermissionsEntryGranted(entry awstypes.BatchPermissionsRequestEntry, permissions []awstypes.Permission) bool {
+	if len(permissions) == 0 {
+		return false
+	}
+
+	if slices.Contains(permissions, awstypes.PermissionAll) {
+		return true
+	}
+
+	for _, permission := range entry.Permissions {
+		if !slices.Contains(permissions, permission) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenBatchPermissionsEntryGrantOptions returns the entry's permissions with grant option that are still granted,
+// so that grant options revoked outside Terraform show up as a difference.
+func flattenBatchPermissionsEntryGrantOptions(entry awstypes.BatchPermissionsRequestEntry, permissionsWithGrantOption []awstypes.Permission) []awstypes.Permission {
+	if slices.Contains(permissionsWithGrantOption, awstypes.PermissionAll) {
+		return entry.PermissionsWithGrantOption
+	}
+
+	var apiObjects []awstypes.Permission
+	for _, permission := range entry.PermissionsWithGrantOption {
+		if slices.Contains(permissionsWithGrantOption, permission) {
+			apiObjects = append(apiObjects, permission)
+		}
+	}
+
+	return apiObjects
+}
+
+func batchPermissionsResourceMatches(want, got *awstypes.Resource) bool {
+	if want == nil || got == nil {
+		return false
+	}
+
+	switch {
+	case want.Catalog != nil:
+		return got.Catalog != nil
+	case want.DataLocation != nil:
+		return got.DataLocation != nil && aws.ToString(got.DataLocation.ResourceArn) == aws.ToString(want.DataLocation.ResourceArn)
+	case want.Database != nil:
+		return got.Database != nil && aws.ToString(got.Database.Name) == aws.ToString(want.Database.Name)
+	case want.Table != nil:
+		databaseName := aws.ToString(want.Table.DatabaseName)
+
+		if want.Table.TableWildcard != nil {
+			if got.Table != nil && aws.ToString(got.Table.DatabaseName) == databaseName {
+				return got.Table.TableWildcard != nil || aws.ToString(got.Table.Name) == TableNameAllTables
+			}
+
+			return got.TableWithColumns != nil && aws.ToString(got.TableWithColumns.DatabaseName) == databaseName && aws.ToString(got.TableWithColumns.Name) == TableNameAllTables
+		}
+
+		name := aws.ToString(want.Table.Name)
+
+		if got.Table != nil {
+			return aws.ToString(got.Table.DatabaseName) == databaseName && aws.ToString(got.Table.Name) == name
+		}
+
+		return got.TableWithColumns != nil && aws.ToString(got.TableWithColumns.DatabaseName) == databaseName && aws.ToString(got.TableWithColumns.Name) == name && got.TableWithColumns.ColumnWildcard != nil
+	case want.TableWithColumns != nil:
+		return got.TableWithColumns != nil && aws.ToString(got.TableWithColumns.DatabaseName) == aws.ToString(want.TableWithColumns.DatabaseName) && aws.ToString(got.TableWithColumns.Name) == aws.ToString(want.TableWithColumns.Name)
+	}
+
+	return false
+}
+
+func countBatchPermissionsResources(apiObject *awstypes.Resource) int {
+	if apiObject == nil {
+		return 0
+	}
+
+	n := 0
+
+	if apiObject.Catalog != nil {
+		n++
+	}
+
+	if apiObject.DataLocation != nil {
+		n++
+	}
+
+	if apiObject.Database != nil {
+		n++
+	}
+
+	if apiObject.Table != nil {
+		n++
+	}
+
+	if apiObject.TableWithColumns != nil {
+		n++
+	}
+
+	return n
+}
+
+func expandBatchPermissionsRequestEntries(tfList []interface{}) []awstypes.BatchPermissionsRequestEntry {
+	apiObjects := make([]awstypes.BatchPermissionsRequestEntry, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := expandBatchPermissionsRequestEntry(tfMap)
+		apiObject.Id = aws.String(strconv.Itoa(i))
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBatchPermissionsRequestEntry(tfMap map[string]interface{}) awstypes.BatchPermissionsRequestEntry {
+	apiObject := awstypes.BatchPermissionsRequestEntry{
+		Resource: &awstypes.Resource{},
+	}
+
+	if v, ok := tfMap[names.AttrPermissions].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Permissions = flex.ExpandStringyValueSet[awstypes.Permission](v)
+	}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PermissionsWithGrantOption = flex.ExpandStringyValueSet[awstypes.Permission](v)
+	}
+
+	if v, ok := tfMap[names.AttrPrincipal].(string); ok && v != "" {
+		apiObject.Principal = &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Resource.Catalog = ExpandCatalogResource()
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrDatabase].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Table = ExpandTableResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.TableWithColumns = expandTableColumnsResource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBatchPermissionsRevocations(t *testing.T) {
+	t.Parallel()
+
+	database := func(name string) *awstypes.Resource {
+		return &awstypes.Resource{Database: &awstypes.DatabaseResource{Name: aws.String(name)}}
+	}
+	entry := func(principal, name string, permissions ...awstypes.Permission) awstypes.BatchPermissionsRequestEntry {
+		return awstypes.BatchPermissionsRequestEntry{
+			Permissions: permissions,
+			Principal:   &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+			Resource:    database(name),
+		}
+	}
+
+	testCases := map[string]struct {
+		removed  []awstypes.BatchPermissionsRequestEntry
+		added    []awstypes.BatchPermissionsRequestEntry
+		expected []awstypes.BatchPermissionsRequestEntry
+	}{
+		"removed": {
+			removed:  []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+			expected: []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+		},
+		"permissions reduced": {
+			removed:  []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionAlter, awstypes.PermissionDescribe)},
+			added:    []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+			expected: []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionAlter)},
+		},
+		"permissions increased": {
+			removed: []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+			added:   []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionAlter, awstypes.PermissionDescribe)},
+		},
+		"different resource": {
+			removed:  []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+			added:    []awstypes.BatchPermissionsRequestEntry{entry("p1", "db2", awstypes.PermissionDescribe)},
+			expected: []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+		},
+		"different principal": {
+			removed:  []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+			added:    []awstypes.BatchPermissionsRequestEntry{entry("p2", "db1", awstypes.PermissionDescribe)},
+			expected: []awstypes.BatchPermissionsRequestEntry{entry("p1", "db1", awstypes.PermissionDescribe)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflakeformation.BatchPermissionsRevocations(testCase.removed, testCase.added)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty(), cmp.AllowUnexported(awstypes.BatchPermissionsRequestEntry{}, awstypes.DataLakePrincipal{}, awstypes.Resource{}, awstypes.DatabaseResource{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenBatchPermissionsEntryGrantOptions(t *testing.T) {
+	t.Parallel()
+
+	entry := awstypes.BatchPermissionsRequestEntry{
+		PermissionsWithGrantOption: []awstypes.Permission{awstypes.PermissionAlter, awstypes.PermissionDescribe},
+	}
+
+	testCases := map[string]struct {
+		granted  []awstypes.Permission
+		expected []awstypes.Permission
+	}{
+		"all granted": {
+			granted:  []awstypes.Permission{awstypes.PermissionAlter, awstypes.PermissionDescribe},
+			expected: []awstypes.Permission{awstypes.PermissionAlter, awstypes.PermissionDescribe},
+		},
+		"some revoked": {
+			granted:  []awstypes.Permission{awstypes.PermissionDescribe},
+			expected: []awstypes.Permission{awstypes.PermissionDescribe},
+		},
+		"all revoked": {},
+		"ALL granted": {
+			granted:  []awstypes.Permission{awstypes.PermissionAll},
+			expected: []awstypes.Permission{awstypes.PermissionAlter, awstypes.PermissionDescribe},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflakeformation.FlattenBatchPermissionsEntryGrantOptions(entry, testCase.granted)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPermissionsBatchEntryDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	entries := func(n int) []interface{} {
+		tfList := make([]interface{}, 0, n)
+		for i := range n {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrDatabase: []interface{}{
+					map[string]interface{}{
+						names.AttrCatalogID: "123456789012",
+						names.AttrName:      fmt.Sprintf("database-%d", i),
+					},
+				},
+				names.AttrPermissions: []interface{}{"DESCRIBE"},
+				names.AttrPrincipal:   "arn:aws:iam::123456789012:role/example", // lintignore:AWSAT005
+			})
+		}
+		return tfList
+	}
+
+	testCases := map[string]struct {
+		old, new int
+	}{
+		"entry added": {
+			old: 3,
+			new: 4,
+		},
+		"entry removed": {
+			old: 3,
+			new: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tflakeformation.ResourcePermissionsBatch()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"entry": entries(testCase.old),
+			})
+			d.SetId("1234")
+
+			diff, err := r.Diff(ctx, d.State(), terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"entry": entries(testCase.new),
+			}), nil)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff == nil || diff.Empty() {
+				t.Fatal("expected a diff")
+			}
+
+			if diff.RequiresNew() {
+				t.Errorf("expected an in-place update, got replacement: %s", diff.GoString())
+			}
+		})
+	}
+}
+
+func testAccPermissionsBatch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsBatchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "6"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      acctest.Ct1,
+						"permissions.#":   "3",
+						"database.0.name": rName + "-0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"table.#":                         acctest.Ct1,
+						"permissions.#":                   acctest.Ct1,
+						"table.0.database_name":           rName + "-0",
+						"table.0.wildcard":                acctest.CtTrue,
+						"permissions_with_grant_option.#": acctest.Ct0,
+					}),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionsBatch_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsBatchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourcePermissionsBatch(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsBatchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_permissions_batch" {
+				continue
+			}
+
+			n, err := permissionsBatchPrincipalGrantCount(ctx, conn, rs)
+
+			if err != nil {
+				return err
+			}
+
+			if n != 0 {
+				return fmt.Errorf("Lake Formation Permissions Batch %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsBatchExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		count, err := permissionsBatchPrincipalGrantCount(ctx, conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Lake Formation Permissions Batch %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// permissionsBatchPrincipalGrantCount returns the number of grants held by the principals in the resource's entries.
+func permissionsBatchPrincipalGrantCount(ctx context.Context, conn *lakeformation.Client, rs *terraform.ResourceState) (int, error) {
+	principals := make(map[string]struct{})
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "entry.") && strings.HasSuffix(k, ".principal") {
+			principals[v] = struct{}{}
+		}
+	}
+
+	grants, err := tflakeformation.FindPermissions(ctx, conn, &lakeformation.ListPermissionsInput{})
+
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, grant := range grants {
+		if grant.Principal == nil {
+			continue
+		}
+
+		if _, ok := principals[aws.ToString(grant.Principal.DataLakePrincipalIdentifier)]; ok {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func testAccPermissionsBatchConfig_basic(rName string, count int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_iam_role" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  dynamic "entry" {
+    for_each = range(%[2]d)
+
+    content {
+      permissions = ["ALTER", "CREATE_TABLE", "DROP"]
+      principal   = aws_iam_role.test[entry.value].arn
+
+      database {
+        name = aws_glue_catalog_database.test[entry.value].name
+      }
+    }
+  }
+
+  dynamic "entry" {
+    for_each = range(%[2]d)
+
+    content {
+      permissions = ["SELECT"]
+      principal   = aws_iam_role.test[entry.value].arn
+
+      table {
+        database_name = aws_glue_catalog_database.test[entry.value].name
+        wildcard      = true
+      }
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, count)
+}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Alert")
+// @Tags(identifierAttribute="arn")
+func newAlertResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &alertResource{}, nil
+}
+
+type alertResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *alertResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lookoutmetrics_alert"
+}
+
+func (r *alertResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alert_sensitivity_threshold": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"alert_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AlertType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"anomaly_detector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AlertStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrAction: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[actionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lambda_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("lambda_configuration"),
+									path.MatchRelative().AtParent().AtName("sns_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"lambda_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"sns_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[snsConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"sns_format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.SnsFormat](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"sns_topic_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alert_filters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[alertFiltersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric_list": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"dimension_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionFilterModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dimension_name": schema.StringAttribute{
+										Required: true,
+									},
+									"dimension_value_list": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *alertResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data alertResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	input := &lookoutmetrics.CreateAlertInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAlert(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lookout for Metrics Alert (%s)", data.AlertName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AlertARN = fwflex.StringToFramework(ctx, output.AlertArn)
+	data.setID()
+
+	alert, err := findAlertByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Alert (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, alert, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *alertResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data alertResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	output, err := findAlertByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Alert (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *alertResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new alertResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	if !new.Action.Equal(old.Action) ||
+		!new.AlertDescription.Equal(old.AlertDescription) ||
+		!new.AlertFilters.Equal(old.AlertFilters) ||
+		!new.AlertSensitivityThreshold.Equal(old.AlertSensitivityThreshold) {
+		input := &lookoutmetrics.UpdateAlertInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateAlert(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Alert (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		alert, err := findAlertByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Alert (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, alert, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *alertResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data alertResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	_, err := conn.DeleteAlert(ctx, &lookoutmetrics.DeleteAlertInput{
+		AlertArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lookout for Metrics Alert (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *alertResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAlertByARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*awstypes.Alert, error) {
+	input := &lookoutmetrics.DescribeAlertInput{
+		AlertArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAlert(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Alert == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Alert, nil
+}
+
+type alertResourceModel struct {
+	Action                    fwtypes.ListNestedObjectValueOf[actionModel]       `tfsdk:"action"`
+	AlertARN                  types.String                                       `tfsdk:"arn"`
+	AlertDescription          types.String                                       `tfsdk:"description"`
+	AlertFilters              fwtypes.ListNestedObjectValueOf[alertFiltersModel] `tfsdk:"alert_filters"`
+	AlertName                 types.String                                       `tfsdk:"name"`
+	AlertSensitivityThreshold types.Int64                                        `tfsdk:"alert_sensitivity_threshold"`
+	AlertStatus               fwtypes.StringEnum[awstypes.AlertStatus]           `tfsdk:"status"`
+	AlertType                 fwtypes.StringEnum[awstypes.AlertType]             `tfsdk:"alert_type"`
+	AnomalyDetectorARN        fwtypes.ARN                                        `tfsdk:"anomaly_detector_arn"`
+	ID                        types.String                                       `tfsdk:"id"`
+	Tags                      types.Map                                          `tfsdk:"tags"`
+	TagsAll                   types.Map                                          `tfsdk:"tags_all"`
+}
+
+func (data *alertResourceModel) InitFromID() error {
+	data.AlertARN = data.ID
+
+	return nil
+}
+
+func (data *alertResourceModel) setID() {
+	data.ID = data.AlertARN
+}
+
+type actionModel struct {
+	LambdaConfiguration fwtypes.ListNestedObjectValueOf[lambdaConfigurationModel] `tfsdk:"lambda_configuration"`
+	SNSConfiguration    fwtypes.ListNestedObjectValueOf[snsConfigurationModel]    `tfsdk:"sns_configuration"`
+}
+
+type lambdaConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type snsConfigurationModel struct {
+	RoleARN     fwtypes.ARN                            `tfsdk:"role_arn"`
+	SnsFormat   fwtypes.StringEnum[awstypes.SnsFormat] `tfsdk:"sns_format"`
+	SnsTopicARN fwtypes.ARN                            `tfsdk:"sns_topic_arn"`
+}
+
+type alertFiltersModel struct {
+	DimensionFilterList fwtypes.ListNestedObjectValueOf[dimensionFilterModel] `tfsdk:"dimension_filter"`
+	MetricList          fwtypes.SetValueOf[types.String]                      `tfsdk:"metric_list"`
+}
+
+type dimensionFilterModel struct {
+	DimensionName      types.String                     `tfsdk:"dimension_name"`
+	DimensionValueList fwtypes.SetValueOf[types.String] `tfsdk:"dimension_value_list"`
+}
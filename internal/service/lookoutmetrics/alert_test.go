@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflookoutmetrics "github.com/hashicorp/terraform-provider-aws/internal/service/lookoutmetrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// There is no anomaly detector resource, so the tests run against an existing detector.
+const envVarAnomalyDetectorARN = "LOOKOUTMETRICS_ANOMALY_DETECTOR_ARN"
+
+func TestAccLookoutMetricsAlert_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_alert.test"
+	anomalyDetectorARN := acctest.SkipIfEnvVarNotSet(t, envVarAnomalyDetectorARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_basic(rName, anomalyDetectorARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "action.0.lambda_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "action.0.sns_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.sns_configuration.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "50"),
+					resource.TestCheckResourceAttr(resourceName, "alert_type", string(awstypes.AlertTypeSns)),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_detector_arn", anomalyDetectorARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_alert.test"
+	anomalyDetectorARN := acctest.SkipIfEnvVarNotSet(t, envVarAnomalyDetectorARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_basic(rName, anomalyDetectorARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflookoutmetrics.ResourceAlert, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_alertFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_alert.test"
+	anomalyDetectorARN := acctest.SkipIfEnvVarNotSet(t, envVarAnomalyDetectorARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_alertFilters(rName, anomalyDetectorARN, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.dimension_filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.dimension_filter.0.dimension_name", "region"),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.dimension_filter.0.dimension_value_list.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.metric_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "70"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlertConfig_alertFilters(rName, anomalyDetectorARN, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlertDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lookoutmetrics_alert" {
+				continue
+			}
+
+			_, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lookout for Metrics Alert %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlertExists(ctx context.Context, n string, v *awstypes.Alert) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		output, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlertConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAlertConfig_basic(rName, anomalyDetectorARN string) string {
+	return acctest.ConfigCompose(testAccAlertConfig_base(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  anomaly_detector_arn        = %[2]q
+  alert_sensitivity_threshold = 50
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.test.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, anomalyDetectorARN))
+}
+
+func testAccAlertConfig_alertFilters(rName, anomalyDetectorARN string, threshold int) string {
+	return acctest.ConfigCompose(testAccAlertConfig_base(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  anomaly_detector_arn        = %[2]q
+  alert_sensitivity_threshold = %[3]d
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.test.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+      sns_format    = "JSON"
+    }
+  }
+
+  alert_filters {
+    metric_list = ["revenue"]
+
+    dimension_filter {
+      dimension_name       = "region"
+      dimension_value_list = ["us-east-1", "us-west-2"]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, anomalyDetectorARN, threshold))
+}
@@ -46,6 +46,25 @@ func ResourceClassificationJob() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"managed_data_identifier_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_data_identifier_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.ManagedDataIdentifierSelector_Values(), false),
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
 			"schedule_frequency": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -122,9 +141,9 @@ func ResourceClassificationJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bucket_definitions": {
-							ConflictsWith: []string{"s3_job_definition.0.bucket_criteria"},
-							Type:          schema.TypeList,
-							Optional:      true,
+							ExactlyOneOf: []string{"s3_job_definition.0.bucket_criteria", "s3_job_definition.0.bucket_definitions"},
+							Type:         schema.TypeList,
+							Optional:     true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrAccountID: {
@@ -140,11 +159,11 @@ func ResourceClassificationJob() *schema.Resource {
 							},
 						},
 						"bucket_criteria": {
-							ConflictsWith: []string{"s3_job_definition.0.bucket_definitions"},
-							Type:          schema.TypeList,
-							Optional:      true,
-							Computed:      true,
-							MaxItems:      1,
+							ExactlyOneOf: []string{"s3_job_definition.0.bucket_criteria", "s3_job_definition.0.bucket_definitions"},
+							Type:         schema.TypeList,
+							Optional:     true,
+							Computed:     true,
+							MaxItems:     1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"excludes": {
@@ -552,6 +571,13 @@ func ResourceClassificationJob() *schema.Resource {
 	}
 }
 func resourceClassificationJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Managed data identifier IDs are only valid when explicitly including or excluding identifiers.
+	if v, ok := diff.GetOk("managed_data_identifier_ids"); ok && len(v.([]interface{})) > 0 {
+		if selector := diff.Get("managed_data_identifier_selector").(string); selector != macie2.ManagedDataIdentifierSelectorInclude && selector != macie2.ManagedDataIdentifierSelectorExclude {
+			return fmt.Errorf("managed_data_identifier_ids can only be specified when managed_data_identifier_selector is %q or %q", macie2.ManagedDataIdentifierSelectorInclude, macie2.ManagedDataIdentifierSelectorExclude)
+		}
+	}
+
 	//TagScopeTerm() enforces the `target` key even though documentation marks it as optional.
 	//ClassificationJobs criteria and scoping cannot be updated.
 	//The API as of Aug 7, 2022 returns an empty string (even if a target was sent), causing a diff on new plans.
@@ -593,6 +619,12 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
 		input.CustomDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("managed_data_identifier_ids"); ok {
+		input.ManagedDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("managed_data_identifier_selector"); ok {
+		input.ManagedDataIdentifierSelector = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("schedule_frequency"); ok {
 		input.ScheduleFrequency = expandScheduleFrequency(v.([]interface{}))
 	}
@@ -660,6 +692,10 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 	if err = d.Set("custom_data_identifier_ids", flex.FlattenStringList(resp.CustomDataIdentifierIds)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "custom_data_identifier_ids", d.Id(), err)
 	}
+	if err = d.Set("managed_data_identifier_ids", flex.FlattenStringList(resp.ManagedDataIdentifierIds)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "managed_data_identifier_ids", d.Id(), err)
+	}
+	d.Set("managed_data_identifier_selector", resp.ManagedDataIdentifierSelector)
 	if err = d.Set("schedule_frequency", flattenScheduleFrequency(resp.ScheduleFrequency)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "schedule_frequency", d.Id(), err)
 	}
@@ -391,6 +391,74 @@ func testAccClassificationJob_BucketCriteria(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_ManagedDataIdentifiers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_managedDataIdentifiers(bucketName, macie2.ManagedDataIdentifierSelectorExclude),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_selector", macie2.ManagedDataIdentifierSelectorExclude),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.0", "AWS_CREDENTIALS"),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.1", "CREDIT_CARD_NUMBER"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClassificationJob_Revision(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output, macie2Output2 macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_revision(bucketName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"revision"},
+			},
+			{
+				Config: testAccClassificationJobConfig_revision(bucketName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output2),
+					testAccCheckClassificationJobRecreated(&macie2Output, &macie2Output2),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationJobExists(ctx context.Context, resourceName string, macie2Session *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -457,6 +525,16 @@ func testAccCheckClassificationJobNotRecreated(i, j *macie2.DescribeClassificati
 	}
 }
 
+func testAccCheckClassificationJobRecreated(i, j *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.JobId) == aws.StringValue(j.JobId) {
+			return fmt.Errorf("Macie Classification Job not recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccClassificationJobConfig_nameGenerated(bucketName, jobType string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -765,3 +843,55 @@ resource "aws_macie2_classification_job" "test" {
 }
 `, jobStatus, description)
 }
+
+func testAccClassificationJobConfig_managedDataIdentifiers(bucketName, selector string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_classification_job" "test" {
+  depends_on = [aws_macie2_account.test]
+  job_type   = "ONE_TIME"
+
+  managed_data_identifier_selector = %[2]q
+  managed_data_identifier_ids      = ["AWS_CREDENTIALS", "CREDIT_CARD_NUMBER"]
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+}
+`, bucketName, selector)
+}
+
+func testAccClassificationJobConfig_revision(bucketName string, revision int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_classification_job" "test" {
+  depends_on = [aws_macie2_account.test]
+  job_type   = "ONE_TIME"
+  revision   = %[2]d
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+}
+`, bucketName, revision)
+}
@@ -24,14 +24,16 @@ func TestAccMacie2_serial(t *testing.T) {
 			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
 		},
 		"ClassificationJob": {
-			acctest.CtBasic:      testAccClassificationJob_basic,
-			"name_generated":     testAccClassificationJob_Name_Generated,
-			"name_prefix":        testAccClassificationJob_NamePrefix,
-			acctest.CtDisappears: testAccClassificationJob_disappears,
-			"status":             testAccClassificationJob_Status,
-			"complete":           testAccClassificationJob_complete,
-			"tags":               testAccClassificationJob_WithTags,
-			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
+			acctest.CtBasic:            testAccClassificationJob_basic,
+			"name_generated":           testAccClassificationJob_Name_Generated,
+			"name_prefix":              testAccClassificationJob_NamePrefix,
+			acctest.CtDisappears:       testAccClassificationJob_disappears,
+			"status":                   testAccClassificationJob_Status,
+			"complete":                 testAccClassificationJob_complete,
+			"tags":                     testAccClassificationJob_WithTags,
+			"bucket_criteria":          testAccClassificationJob_BucketCriteria,
+			"managed_data_identifiers": testAccClassificationJob_ManagedDataIdentifiers,
+			"revision":                 testAccClassificationJob_Revision,
 		},
 		"CustomDataIdentifier": {
 			acctest.CtBasic:      testAccCustomDataIdentifier_basic,
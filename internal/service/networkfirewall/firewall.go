@@ -373,6 +373,10 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
 			}
+
+			if err := waitFirewallSubnetsAssociated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id(), subnetMappingIDs(subnetsToAdd)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) subnets associate: %s", d.Id(), err)
+			}
 		}
 
 		if len(subnetsToRemove) > 0 {
@@ -390,6 +394,10 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				if err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
 				}
+
+				if err := waitFirewallSubnetsDisassociated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id(), subnetsToRemove); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) subnets disassociate: %s", d.Id(), err)
+				}
 			} else if !tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidRequestException, "inaccessible") {
 				return sdkdiag.AppendErrorf(diags, "disassociating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
 			}
@@ -465,6 +473,148 @@ func statusFirewall(ctx context.Context, conn *networkfirewall.NetworkFirewall,
 	}
 }
 
+// firewallSubnetAttachments returns the firewall's endpoint attachments keyed by subnet ID.
+func firewallSubnetAttachments(output *networkfirewall.DescribeFirewallOutput) map[string]*networkfirewall.Attachment {
+	attachments := make(map[string]*networkfirewall.Attachment)
+
+	if output.FirewallStatus != nil {
+		for _, syncState := range output.FirewallStatus.SyncStates {
+			if syncState == nil || syncState.Attachment == nil {
+				continue
+			}
+
+			attachments[aws.StringValue(syncState.Attachment.SubnetId)] = syncState.Attachment
+		}
+	}
+
+	return attachments
+}
+
+// attachmentStatusRank orders attachment statuses from most to least ready so that the result doesn't depend on subnet order.
+func attachmentStatusRank(status string) int {
+	switch status {
+	case networkfirewall.AttachmentStatusReady:
+		return 0
+	case networkfirewall.AttachmentStatusScaling:
+		return 1
+	case networkfirewall.AttachmentStatusCreating:
+		return 2
+	case networkfirewall.AttachmentStatusDeleting:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// attachmentFailure returns the status of a failed attachment, with its status message as an error if one is set.
+func attachmentFailure(subnetID string, attachment *networkfirewall.Attachment) (string, error) {
+	status := aws.StringValue(attachment.Status)
+
+	if status != networkfirewall.AttachmentStatusFailed && status != networkfirewall.AttachmentStatusError {
+		return "", nil
+	}
+
+	if v := aws.StringValue(attachment.StatusMessage); v != "" {
+		return status, fmt.Errorf("subnet %s: %s", subnetID, v)
+	}
+
+	return status, nil
+}
+
+// statusFirewallSubnetsAssociated returns the least ready status of the firewall endpoints in the specified subnets.
+// A subnet without an endpoint yet is reported as CREATING.
+func statusFirewallSubnetsAssociated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, subnetIDs []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFirewallByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status, err := firewallSubnetsAssociatedStatus(output, subnetIDs)
+
+		return output, status, err
+	}
+}
+
+// statusFirewallSubnetsDisassociated returns the least ready status of the firewall endpoints remaining in the specified subnets.
+// Subnets without an endpoint have been disassociated. The firewall is returned only while at least one endpoint remains.
+func statusFirewallSubnetsDisassociated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, subnetIDs []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFirewallByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status, err := firewallSubnetsDisassociatedStatus(output, subnetIDs)
+
+		if status == "" {
+			return nil, "", nil
+		}
+
+		return output, status, err
+	}
+}
+
+func firewallSubnetsAssociatedStatus(output *networkfirewall.DescribeFirewallOutput, subnetIDs []string) (string, error) {
+	attachments := firewallSubnetAttachments(output)
+	status := networkfirewall.AttachmentStatusReady
+
+	for _, subnetID := range subnetIDs {
+		attachment, ok := attachments[subnetID]
+
+		if !ok {
+			// Not yet attached.
+			if attachmentStatusRank(networkfirewall.AttachmentStatusCreating) > attachmentStatusRank(status) {
+				status = networkfirewall.AttachmentStatusCreating
+			}
+			continue
+		}
+
+		if v, err := attachmentFailure(subnetID, attachment); v != "" {
+			return v, err
+		}
+
+		if v := aws.StringValue(attachment.Status); attachmentStatusRank(v) > attachmentStatusRank(status) {
+			status = v
+		}
+	}
+
+	return status, nil
+}
+
+func firewallSubnetsDisassociatedStatus(output *networkfirewall.DescribeFirewallOutput, subnetIDs []string) (string, error) {
+	attachments := firewallSubnetAttachments(output)
+	var status string
+
+	for _, subnetID := range subnetIDs {
+		attachment, ok := attachments[subnetID]
+
+		if !ok {
+			continue
+		}
+
+		if v, err := attachmentFailure(subnetID, attachment); v != "" {
+			return v, err
+		}
+
+		if v := aws.StringValue(attachment.Status); status == "" || attachmentStatusRank(v) > attachmentStatusRank(status) {
+			status = v
+		}
+	}
+
+	return status, nil
+}
+
 func waitFirewallCreated(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string) (*networkfirewall.Firewall, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{networkfirewall.FirewallStatusValueProvisioning},
@@ -503,6 +653,34 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.NetworkFirew
 	return "", err
 }
 
+// waitFirewallSubnetsAssociated waits until the firewall's endpoints in each of the specified subnets are ready.
+func waitFirewallSubnetsAssociated(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string, subnetIDs []string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.AttachmentStatusCreating, networkfirewall.AttachmentStatusScaling},
+		Target:  []string{networkfirewall.AttachmentStatusReady},
+		Refresh: statusFirewallSubnetsAssociated(ctx, conn, arn, subnetIDs),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitFirewallSubnetsDisassociated waits until the firewall no longer has endpoints in any of the specified subnets.
+func waitFirewallSubnetsDisassociated(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string, subnetIDs []string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.AttachmentStatusDeleting, networkfirewall.AttachmentStatusReady, networkfirewall.AttachmentStatusScaling},
+		Target:  []string{},
+		Refresh: statusFirewallSubnetsDisassociated(ctx, conn, arn, subnetIDs),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string) (*networkfirewall.Firewall, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{networkfirewall.FirewallStatusValueDeleting},
@@ -554,6 +732,14 @@ func expandSubnetMappingIDs(l []interface{}) []string {
 	return ids
 }
 
+func subnetMappingIDs(apiObjects []*networkfirewall.SubnetMapping) []string {
+	ids := make([]string, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		ids = append(ids, aws.StringValue(apiObject.SubnetId))
+	}
+	return ids
+}
+
 func flattenFirewallStatus(status *networkfirewall.FirewallStatus) []interface{} {
 	if status == nil {
 		return nil
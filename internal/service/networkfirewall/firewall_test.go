@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +22,150 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFirewallSubnetsStatus(t *testing.T) {
+	t.Parallel()
+
+	syncState := func(subnetID, status, configStatus string) *networkfirewall.SyncState {
+		return &networkfirewall.SyncState{
+			Attachment: &networkfirewall.Attachment{
+				EndpointId: aws.String("vpce-" + subnetID),
+				Status:     aws.String(status),
+				SubnetId:   aws.String(subnetID),
+			},
+			Config: map[string]*networkfirewall.PerObjectStatus{
+				"policy": {
+					SyncStatus: aws.String(configStatus),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                        string
+		syncStates                  []*networkfirewall.SyncState
+		subnetIDs                   []string
+		expectedAssociatedStatus    string
+		expectedDisassociatedStatus string
+		expectError                 bool
+	}{
+		{
+			name:                     "no sync states",
+			subnetIDs:                []string{"subnet-1"},
+			expectedAssociatedStatus: networkfirewall.AttachmentStatusCreating,
+		},
+		{
+			name: "all ready and in sync",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+				syncState("subnet-2", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+			},
+			subnetIDs:                   []string{"subnet-1", "subnet-2"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusReady,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusReady,
+		},
+		{
+			name: "ready with configuration pending",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusPending),
+			},
+			subnetIDs:                   []string{"subnet-1"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusReady,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusReady,
+		},
+		{
+			name: "ready and missing subnet",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+			},
+			subnetIDs:                   []string{"subnet-1", "subnet-2"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusCreating,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusReady,
+		},
+		{
+			name: "ready and scaling",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusScaling, networkfirewall.PerObjectSyncStatusPending),
+				syncState("subnet-2", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+			},
+			subnetIDs:                   []string{"subnet-2", "subnet-1"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusScaling,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusScaling,
+		},
+		{
+			name: "ready and deleting",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+				syncState("subnet-2", networkfirewall.AttachmentStatusDeleting, networkfirewall.PerObjectSyncStatusPending),
+			},
+			subnetIDs:                   []string{"subnet-1", "subnet-2"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusDeleting,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusDeleting,
+		},
+		{
+			name: "unrelated subnet ignored",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusDeleting, networkfirewall.PerObjectSyncStatusPending),
+			},
+			subnetIDs:                []string{"subnet-2"},
+			expectedAssociatedStatus: networkfirewall.AttachmentStatusCreating,
+		},
+		{
+			name: "failed",
+			syncStates: []*networkfirewall.SyncState{
+				syncState("subnet-1", networkfirewall.AttachmentStatusReady, networkfirewall.PerObjectSyncStatusInSync),
+				{
+					Attachment: &networkfirewall.Attachment{
+						Status:        aws.String(networkfirewall.AttachmentStatusFailed),
+						StatusMessage: aws.String("insufficient capacity"),
+						SubnetId:      aws.String("subnet-2"),
+					},
+				},
+			},
+			subnetIDs:                   []string{"subnet-1", "subnet-2"},
+			expectedAssociatedStatus:    networkfirewall.AttachmentStatusFailed,
+			expectedDisassociatedStatus: networkfirewall.AttachmentStatusFailed,
+			expectError:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := &networkfirewall.DescribeFirewallOutput{
+				FirewallStatus: &networkfirewall.FirewallStatus{
+					SyncStates: map[string]*networkfirewall.SyncState{},
+				},
+			}
+			for _, v := range testCase.syncStates {
+				output.FirewallStatus.SyncStates[aws.StringValue(v.Attachment.SubnetId)] = v
+			}
+
+			status, err := tfnetworkfirewall.FirewallSubnetsAssociatedStatus(output, testCase.subnetIDs)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("associated error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := status, testCase.expectedAssociatedStatus; got != want {
+				t.Errorf("associated status = %q, want %q", got, want)
+			}
+
+			status, err = tfnetworkfirewall.FirewallSubnetsDisassociatedStatus(output, testCase.subnetIDs)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("disassociated error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := status, testCase.expectedDisassociatedStatus; got != want {
+				t.Errorf("disassociated status = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallFirewall_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
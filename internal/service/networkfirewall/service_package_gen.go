@@ -76,6 +76,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceTLSInspectionConfiguration,
+			TypeName: "aws_networkfirewall_tls_inspection_configuration",
+			Name:     "TLS Inspection Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 	}
 }
 
@@ -405,7 +405,7 @@ func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.Reso
 	mergedDoc.NetworkFunctionGroups = networkFunctionGroups
 
 	// SegmentActions
-	segment_actions, err := expandCoreNetworkPolicySegmentActions(d.Get("segment_actions").([]interface{}))
+	segment_actions, err := expandCoreNetworkPolicySegmentActions(d.Get("segment_actions").([]interface{}), networkFunctionGroups)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -430,8 +430,12 @@ func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.Reso
 	return diags
 }
 
-func expandCoreNetworkPolicySegmentActions(tfList []interface{}) ([]*coreNetworkPolicySegmentAction, error) {
+func expandCoreNetworkPolicySegmentActions(tfList []interface{}, networkFunctionGroups []*coreNetworkPolicyNetworkFunctionGroup) ([]*coreNetworkPolicySegmentAction, error) {
 	apiObjects := make([]*coreNetworkPolicySegmentAction, 0)
+	networkFunctionGroupNames := make(map[string]struct{})
+	for _, v := range networkFunctionGroups {
+		networkFunctionGroupNames[v.Name] = struct{}{}
+	}
 
 	for i, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -499,6 +503,17 @@ func expandCoreNetworkPolicySegmentActions(tfList []interface{}) ([]*coreNetwork
 				apiObject.Mode = v.(string)
 			}
 
+			switch mode := apiObject.Mode; action {
+			case "send-via":
+				if mode != "" && mode != "single-hop" && mode != "dual-hop" {
+					return nil, fmt.Errorf(`"mode" must be "single-hop" or "dual-hop" if action = "send-via". See segment_actions[%d]`, i)
+				}
+			case "send-to":
+				if mode != "" {
+					return nil, fmt.Errorf(`you cannot specify "mode" if action = "send-to". See segment_actions[%d]`, i)
+				}
+			}
+
 			if v, ok := tfMap["when_sent_to"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 				apiObject.WhenSentTo = &coreNetworkPolicySegmentActionWhenSentTo{}
 
@@ -515,6 +530,12 @@ func expandCoreNetworkPolicySegmentActions(tfList []interface{}) ([]*coreNetwork
 				tfMap := v[0].(map[string]interface{})
 
 				if v := tfMap["network_function_groups"].(*schema.Set).List(); len(v) > 0 {
+					for _, v := range v {
+						if _, ok := networkFunctionGroupNames[v.(string)]; !ok {
+							return nil, fmt.Errorf(`network function group (%s) is not defined in "network_function_groups". See segment_actions[%d]`, v, i)
+						}
+					}
+
 					apiObject.Via.NetworkFunctionGroups = coreNetworkPolicyExpandStringList(v)
 				}
 
@@ -539,6 +560,10 @@ func expandCoreNetworkPolicySegmentActions(tfList []interface{}) ([]*coreNetwork
 					apiObject.Via.WithEdgeOverrides = apiObjects
 				}
 			}
+
+			if apiObject.Via == nil || apiObject.Via.NetworkFunctionGroups == nil {
+				return nil, fmt.Errorf(`you must specify "via.network_function_groups" if action = %q. See segment_actions[%d]`, action, i)
+			}
 		}
 
 		apiObjects = append(apiObjects, apiObject)
@@ -4,8 +4,10 @@
 package networkmanager_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -45,6 +47,67 @@ func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_serviceInsertion(t
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_serviceInsertionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertionValidation("send-via", "attachment-route", "InspectionVPC"),
+				ExpectError: regexache.MustCompile(`"mode" must be "single-hop" or "dual-hop" if action = "send-via"`),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertionValidation("send-to", "single-hop", "InspectionVPC"),
+				ExpectError: regexache.MustCompile(`you cannot specify "mode" if action = "send-to"`),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertionValidation("send-via", "single-hop", "EgressVPC"),
+				ExpectError: regexache.MustCompile(`network function group \(EgressVPC\) is not defined`),
+			},
+		},
+	})
+}
+
+// lintignore:AWSAT003
+func testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertionValidation(action, mode, networkFunctionGroup string) string {
+	if mode != "" {
+		mode = fmt.Sprintf("mode = %q", mode)
+	}
+
+	return fmt.Sprintf(`
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-65534"]
+
+    edge_locations {
+      location = "us-east-2"
+    }
+  }
+
+  segments {
+    name = "development"
+  }
+
+  segment_actions {
+    action  = %[1]q
+    segment = "development"
+    %[2]s
+
+    via {
+      network_function_groups = [%[3]q]
+    }
+  }
+
+  network_function_groups {
+    name                          = "InspectionVPC"
+    require_attachment_acceptance = true
+  }
+}
+`, action, mode, networkFunctionGroup)
+}
+
 // lintignore:AWSAT003
 const testAccCoreNetworkPolicyDocumentDataSourceConfig_basic = `
 data "aws_networkmanager_core_network_policy_document" "test" {
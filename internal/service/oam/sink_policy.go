@@ -5,8 +5,10 @@ package oam
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -19,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -50,15 +55,57 @@ func ResourceSinkPolicy() *schema.Resource {
 			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
-				Required:              true,
+				Optional:              true,
+				Computed:              true,
+				ExactlyOneOf:          []string{names.AttrPolicy, "policy_configuration"},
 				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 			},
+			"policy_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{names.AttrPolicy, "policy_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							AtLeastOneOf: []string{"policy_configuration.0.account_ids", "policy_configuration.0.organization_ids", "policy_configuration.0.organization_paths"},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"organization_ids": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							AtLeastOneOf: []string{"policy_configuration.0.account_ids", "policy_configuration.0.organization_ids", "policy_configuration.0.organization_paths"},
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"organization_paths": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							AtLeastOneOf: []string{"policy_configuration.0.account_ids", "policy_configuration.0.organization_ids", "policy_configuration.0.organization_paths"},
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ResourceType](),
+							},
+						},
+					},
+				},
+			},
 			"sink_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,6 +116,8 @@ func ResourceSinkPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: resourceSinkPolicyCustomizeDiff,
 	}
 }
 
@@ -76,15 +125,121 @@ const (
 	ResNameSinkPolicy = "Sink Policy"
 )
 
+// resourceSinkPolicyCustomizeDiff plans the policy document built from policy_configuration.
+// The document is rebuilt on every plan so that changes made outside Terraform are reverted.
+func resourceSinkPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("policy_configuration")
+	if !ok {
+		return nil
+	}
+
+	if !d.GetRawConfig().GetAttr("policy_configuration").IsWhollyKnown() {
+		return d.SetNewComputed(names.AttrPolicy)
+	}
+
+	policy, err := expandSinkPolicyConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if equivalent, err := tfjson.PoliciesAreEquivalent(d.Get(names.AttrPolicy).(string), policy); err == nil && equivalent {
+		return nil
+	}
+
+	return d.SetNew(names.AttrPolicy, policy)
+}
+
+type sinkPolicyDocument struct {
+	Version   string                 `json:"Version"`
+	Statement []*sinkPolicyStatement `json:"Statement"`
+}
+
+type sinkPolicyStatement struct {
+	Action    []string                  `json:"Action"`
+	Condition map[string]map[string]any `json:"Condition"`
+	Effect    string                    `json:"Effect"`
+	Principal any                       `json:"Principal"`
+	Resource  string                    `json:"Resource"`
+}
+
+// expandSinkPolicyConfiguration returns the JSON sink policy equivalent to the specified policy_configuration.
+// Each kind of principal is granted access in its own statement so that the conditions are not combined.
+func expandSinkPolicyConfiguration(tfMap map[string]interface{}) (string, error) {
+	resourceTypes := flex.ExpandStringValueSet(tfMap["resource_types"].(*schema.Set))
+	slices.Sort(resourceTypes)
+
+	newStatement := func(principal any) *sinkPolicyStatement {
+		return &sinkPolicyStatement{
+			Action: []string{"oam:CreateLink", "oam:UpdateLink"},
+			Condition: map[string]map[string]any{
+				"ForAllValues:StringEquals": {
+					"oam:ResourceTypes": resourceTypes,
+				},
+			},
+			Effect:    "Allow",
+			Principal: principal,
+			Resource:  "*",
+		}
+	}
+
+	document := &sinkPolicyDocument{
+		Version: "2012-10-17",
+	}
+
+	if v, ok := tfMap["account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountIDs := flex.ExpandStringValueSet(v)
+		slices.Sort(accountIDs)
+		document.Statement = append(document.Statement, newStatement(map[string]any{"AWS": accountIDs}))
+	}
+
+	if v, ok := tfMap["organization_ids"].(*schema.Set); ok && v.Len() > 0 {
+		organizationIDs := flex.ExpandStringValueSet(v)
+		slices.Sort(organizationIDs)
+		statement := newStatement("*")
+		statement.Condition["ForAnyValue:StringEquals"] = map[string]any{
+			"aws:PrincipalOrgID": organizationIDs,
+		}
+		document.Statement = append(document.Statement, statement)
+	}
+
+	if v, ok := tfMap["organization_paths"].(*schema.Set); ok && v.Len() > 0 {
+		organizationPaths := flex.ExpandStringValueSet(v)
+		slices.Sort(organizationPaths)
+		statement := newStatement("*")
+		statement.Condition["ForAnyValue:StringLike"] = map[string]any{
+			"aws:PrincipalOrgPaths": organizationPaths,
+		}
+		document.Statement = append(document.Statement, statement)
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
 func resourceSinkPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerClient(ctx)
 
 	sinkIdentifier := d.Get("sink_identifier").(string)
-	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get(names.AttrPolicy).(string), err)
+	var policy string
+	var err error
+	if v, ok := d.GetOk("policy_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy, err = expandSinkPolicyConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building ObservabilityAccessManager Sink Policy (%s): %s", sinkIdentifier, err)
+		}
+	} else {
+		policy, err = structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get(names.AttrPolicy).(string), err)
+		}
 	}
 
 	in := &oam.PutSinkPolicyInput{
@@ -16,6 +16,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -168,6 +169,102 @@ func TestAccObservabilityAccessManagerSinkPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccObservabilityAccessManagerSinkPolicy_policyConfiguration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var sinkPolicy oam.GetSinkPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_oam_sink_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ObservabilityAccessManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSinkPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyConfig_policyConfiguration(rName, `"AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkPolicyExists(ctx, resourceName, &sinkPolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy_configuration.0.account_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy_configuration.0.resource_types.#", acctest.Ct2),
+					resource.TestCheckResourceAttrWith(resourceName, names.AttrPolicy, func(value string) error {
+						_, err := awspolicy.PoliciesAreEquivalent(value, fmt.Sprintf(`
+{
+	"Version": "2012-10-17",
+	"Statement": [{
+		"Action": ["oam:CreateLink", "oam:UpdateLink"],
+		"Effect": "Allow",
+		"Resource": "*",
+		"Principal": { "AWS": ["%[1]s"] },
+		"Condition": {
+			"ForAllValues:StringEquals": {
+				"oam:ResourceTypes": [
+					"AWS::CloudWatch::Metric",
+					"AWS::Logs::LogGroup"
+				]
+			}
+		}
+	}]
+}
+					`, acctest.AccountID()))
+						return err
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_configuration"},
+			},
+			{
+				// The policy is changed outside Terraform and the next apply restores the document built from policy_configuration.
+				PreConfig: func() {
+					testAccPutSinkPolicy(ctx, t, &sinkPolicy, fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": ["oam:CreateLink", "oam:UpdateLink"],
+    "Effect": "Allow",
+    "Resource": "*",
+    "Principal": { "AWS": ["%[1]s"] },
+    "Condition": {
+      "ForAllValues:StringEquals": {
+        "oam:ResourceTypes": ["AWS::CloudWatch::Metric"]
+      }
+    }
+  }]
+}`, acctest.AccountID()))
+				},
+				Config: testAccSinkPolicyConfig_policyConfiguration(rName, `"AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkPolicyExists(ctx, resourceName, &sinkPolicy),
+					resource.TestMatchResourceAttr(resourceName, names.AttrPolicy, regexache.MustCompile(`AWS::Logs::LogGroup`)),
+				),
+			},
+			{
+				Config: testAccSinkPolicyConfig_policyConfiguration(rName, `"AWS::CloudWatch::Metric"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkPolicyExists(ctx, resourceName, &sinkPolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_configuration.0.resource_types.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSinkPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ObservabilityAccessManagerClient(ctx)
@@ -223,6 +320,21 @@ func testAccCheckSinkPolicyExists(ctx context.Context, name string, sinkPolicy *
 	}
 }
 
+func testAccPutSinkPolicy(ctx context.Context, t *testing.T, sinkPolicy *oam.GetSinkPolicyOutput, policy string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ObservabilityAccessManagerClient(ctx)
+
+	_, err := conn.PutSinkPolicy(ctx, &oam.PutSinkPolicyInput{
+		Policy:         aws.String(policy),
+		SinkIdentifier: sinkPolicy.SinkArn,
+	})
+
+	if err != nil {
+		t.Fatalf("putting ObservabilityAccessManager Sink Policy (%s): %s", aws.ToString(sinkPolicy.SinkArn), err)
+	}
+}
+
 func testAccSinkPolicyConfigBasic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -288,3 +400,22 @@ resource "aws_oam_sink_policy" "test" {
 }
 `, rName)
 }
+
+func testAccSinkPolicyConfig_policyConfiguration(rName, resourceTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_oam_sink" "test" {
+  name = %[1]q
+}
+
+resource "aws_oam_sink_policy" "test" {
+  sink_identifier = aws_oam_sink.test.id
+
+  policy_configuration {
+    account_ids    = [data.aws_caller_identity.current.account_id]
+    resource_types = [%[2]s]
+  }
+}
+`, rName, resourceTypes)
+}
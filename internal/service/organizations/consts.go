@@ -5,8 +5,26 @@ package organizations
 
 import (
 	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 const (
 	organizationFinalizationTimeout = 4 * time.Minute
 )
+
+// Policy types supported by AWS Organizations but not yet modeled by the AWS SDK.
+const (
+	policyTypeChatbotPolicy        awstypes.PolicyType = "CHATBOT_POLICY"
+	policyTypeDeclarativePolicyEC2 awstypes.PolicyType = "DECLARATIVE_POLICY_EC2"
+)
+
+func policyTypeValues() []string {
+	return append(enum.Values[awstypes.PolicyType](), enum.Slice(policyTypeChatbotPolicy, policyTypeDeclarativePolicyEC2)...)
+}
+
+const (
+	// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values.
+	serviceControlPolicyMaxSize = 5120
+)
@@ -64,6 +64,8 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
 			"Type_SCP":               testAccPolicy_type_SCP,
+			"Type_SCPTooLarge":       testAccPolicy_type_SCPTooLarge,
+			"Type_DeclarativeEC2":    testAccPolicy_type_DeclarativeEC2,
 			"Type_Tag":               testAccPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
 		},
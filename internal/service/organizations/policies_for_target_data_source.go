@@ -5,6 +5,7 @@ package organizations
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
@@ -13,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -32,6 +32,36 @@ func dataSourcePoliciesForTarget() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"include_inherited": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"inheritance_path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inherited": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"target_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -46,24 +76,87 @@ func dataSourcePoliciesForTargetRead(ctx context.Context, d *schema.ResourceData
 
 	targetID := d.Get("target_id").(string)
 	filter := d.Get(names.AttrFilter).(string)
-	input := &organizations.ListPoliciesForTargetInput{
-		Filter:   awstypes.PolicyType(filter),
-		TargetId: aws.String(targetID),
+
+	path := []string{targetID}
+	if d.Get("include_inherited").(bool) {
+		ancestors, err := findAncestorIDs(ctx, conn, targetID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Organizations parents for target (%s): %s", targetID, err)
+		}
+
+		path = append(path, ancestors...)
 	}
-	policies, err := findPoliciesForTarget(ctx, conn, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Organizations Policies (%s) for target (%s): %s", filter, targetID, err)
+	var ids []string
+	var policies []interface{}
+	seen := make(map[string]bool)
+
+	for _, id := range path {
+		input := &organizations.ListPoliciesForTargetInput{
+			Filter:   awstypes.PolicyType(filter),
+			TargetId: aws.String(id),
+		}
+		output, err := findPoliciesForTarget(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Organizations Policies (%s) for target (%s): %s", filter, id, err)
+		}
+
+		for _, v := range output {
+			policyID := aws.ToString(v.Id)
+
+			policies = append(policies, map[string]interface{}{
+				names.AttrID: policyID,
+				"inherited":  id != targetID,
+				"target_id":  id,
+			})
+
+			// A policy attached at several levels is only reported once in ids.
+			if !seen[policyID] {
+				seen[policyID] = true
+				ids = append(ids, policyID)
+			}
+		}
 	}
 
 	d.SetId(targetID)
-	d.Set(names.AttrIDs, tfslices.ApplyToAll(policies, func(v awstypes.PolicySummary) string {
-		return aws.ToString(v.Id)
-	}))
+	d.Set(names.AttrIDs, ids)
+	d.Set("inheritance_path", path)
+	if err := d.Set("policies", policies); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policies: %s", err)
+	}
 
 	return diags
 }
 
+// findAncestorIDs returns the IDs of the parents of the specified account or organizational unit,
+// nearest first and ending with the root.
+func findAncestorIDs(ctx context.Context, conn *organizations.Client, id string) ([]string, error) {
+	var output []string
+
+	// Roots have no parents.
+	for !strings.HasPrefix(id, "r-") {
+		input := &organizations.ListParentsInput{
+			ChildId: aws.String(id),
+		}
+		parent, err := findParent(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		id = aws.ToString(parent.Id)
+		output = append(output, id)
+
+		if parent.Type == awstypes.ParentTypeRoot {
+			break
+		}
+	}
+
+	return output, nil
+}
+
 func findPoliciesForTarget(ctx context.Context, conn *organizations.Client, input *organizations.ListPoliciesForTargetInput) ([]awstypes.PolicySummary, error) {
 	var output []awstypes.PolicySummary
 
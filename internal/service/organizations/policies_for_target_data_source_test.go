@@ -36,6 +36,36 @@ func TestAccOrganizationsPoliciesForTargetDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationsPoliciesForTargetDataSource_includeInherited(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_organizations_policies_for_target.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesForTargetDataSourceConfig_includeInherited(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "inheritance_path.#", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(datasourceName, "inheritance_path.0", "aws_organizations_organizational_unit.child", names.AttrID),
+					resource.TestCheckResourceAttrPair(datasourceName, "inheritance_path.1", "aws_organizations_organizational_unit.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(datasourceName, "inheritance_path.2", "aws_organizations_organization.test", "roots.0.id"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "policies.*", map[string]string{
+						"inherited": acctest.CtTrue,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "ids.*", "aws_organizations_policy.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccPoliciesForTargetDataSourceConfig_AttachQuery(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
@@ -82,3 +112,50 @@ data "aws_organizations_policy" "test" {
 }
 `, rName)
 }
+
+func testAccPoliciesForTargetDataSourceConfig_includeInherited(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  feature_set          = "ALL"
+  enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_organizational_unit" "child" {
+  name      = "%[1]s-child"
+  parent_id = aws_organizations_organizational_unit.test.id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+    }
+  })
+
+  name = %[1]q
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+
+data "aws_organizations_policies_for_target" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  target_id         = aws_organizations_organizational_unit.child.id
+  filter            = "SERVICE_CONTROL_POLICY"
+  include_inherited = true
+}
+`, rName)
+}
@@ -4,19 +4,23 @@
 package organizations
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -49,6 +53,10 @@ func resourcePolicy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 				DiffSuppressOnRefresh: true,
 				ValidateFunc:          validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					content, _ := structure.NormalizeJsonString(v)
+					return content
+				},
 			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
@@ -65,18 +73,55 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          awstypes.PolicyTypeServiceControlPolicy,
-				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      awstypes.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice(policyTypeValues(), false),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrContent) || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	if policyType := awstypes.PolicyType(d.Get(names.AttrType).(string)); policyType != awstypes.PolicyTypeServiceControlPolicy {
+		return nil
+	}
+
+	n, err := policyContentSize(d.Get(names.AttrContent).(string))
+
+	if err != nil {
+		return err
+	}
+
+	if n > serviceControlPolicyMaxSize {
+		return fmt.Errorf("%s is %d characters, which exceeds the maximum size of a service control policy (%d characters)", names.AttrContent, n, serviceControlPolicyMaxSize)
+	}
+
+	return nil
+}
+
+// policyContentSize returns the size of the specified policy content as AWS Organizations measures it,
+// i.e. the number of characters once whitespace outside of JSON strings has been removed.
+func policyContentSize(content string) (int, error) {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, []byte(content)); err != nil {
+		return 0, fmt.Errorf("compacting %s: %w", names.AttrContent, err)
+	}
+
+	return utf8.RuneCount(buf.Bytes()), nil
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
@@ -121,7 +166,11 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	policySummary := policy.PolicySummary
 	d.Set(names.AttrARN, policySummary.Arn)
-	d.Set(names.AttrContent, policy.Content)
+	content, err := structure.NormalizeJsonString(aws.ToString(policy.Content))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrContent, content)
 	d.Set(names.AttrDescription, policySummary.Description)
 	d.Set(names.AttrName, policySummary.Name)
 	d.Set(names.AttrType, policySummary.Type)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -23,6 +24,52 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestPolicyContentSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected int
+	}{
+		{
+			name:     "compact",
+			content:  `{"Sid":"a"}`,
+			expected: 11,
+		},
+		{
+			name: "whitespace",
+			content: `{
+  "Sid" : "a b"
+}`,
+			expected: 13,
+		},
+		{
+			name:     "non-ASCII",
+			content:  `{"Sid":"日本語"}`,
+			expected: 13,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tforganizations.PolicyContentSize(testCase.content)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("PolicyContentSize = %d, want %d", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var policy awstypes.Policy
@@ -399,6 +446,56 @@ func testAccPolicy_type_SCP(t *testing.T) {
 	})
 }
 
+func testAccPolicy_type_SCPTooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	serviceControlPolicyContent := fmt.Sprintf(`{"Version": "2012-10-17", "Statement": { "Sid": %[1]q, "Effect": "Allow", "Action": "*", "Resource": "*"}}`, strings.Repeat("a", 5120))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_type(rName, serviceControlPolicyContent, string(awstypes.PolicyTypeServiceControlPolicy)),
+				ExpectError: regexache.MustCompile(`exceeds the maximum size of a service control policy`),
+			},
+		},
+	})
+}
+
+func testAccPolicy_type_DeclarativeEC2(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy.test"
+	// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_declarative_syntax.html
+	declarativePolicyContent := `{ "ec2_attributes": { "image_block_public_access": { "state": { "@@assign": "block_new_sharing" } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_type(rName, declarativePolicyContent, "DECLARATIVE_POLICY_EC2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "DECLARATIVE_POLICY_EC2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
 func testAccPolicy_type_Tag(t *testing.T) {
 	ctx := acctest.Context(t)
 	var policy awstypes.Policy
@@ -5,8 +5,9 @@ package qldb
 
 // Exports for use in tests only.
 var (
-	FindLedgerByName       = findLedgerByName
-	FindStreamByTwoPartKey = findStreamByTwoPartKey
+	FindJournalS3ExportByTwoPartKey = findJournalS3ExportByTwoPartKey
+	FindLedgerByName                = findLedgerByName
+	FindStreamByTwoPartKey          = findStreamByTwoPartKey
 
 	ResourceLedger = resourceLedger
 	ResourceStream = resourceStream
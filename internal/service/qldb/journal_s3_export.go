@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qldb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_qldb_journal_s3_export", name="Journal S3 Export")
+func resourceJournalS3Export() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJournalS3ExportCreate,
+		ReadWithoutTimeout:   resourceJournalS3ExportRead,
+		DeleteWithoutTimeout: resourceJournalS3ExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"exclusive_end_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+			},
+			"export_creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inclusive_start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+			},
+			"ledger_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"output_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.OutputFormat](),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_export_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						names.AttrEncryptionConfiguration: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKMSKeyARN: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_encryption_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectEncryptionType](),
+									},
+								},
+							},
+						},
+						names.AttrPrefix: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 128),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceJournalS3ExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
+
+	ledgerName := d.Get("ledger_name").(string)
+	input := &qldb.ExportJournalToS3Input{
+		Name:    aws.String(ledgerName),
+		RoleArn: aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk("exclusive_end_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExclusiveEndTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("inclusive_start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InclusiveStartTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("output_format"); ok {
+		input.OutputFormat = types.OutputFormat(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_export_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3ExportConfiguration = expandS3ExportConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.ExportJournalToS3(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating QLDB Journal S3 Export (%s): %s", ledgerName, err)
+	}
+
+	d.SetId(aws.ToString(output.ExportId))
+
+	if _, err := waitJournalS3ExportCompleted(ctx, conn, ledgerName, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for QLDB Journal S3 Export (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJournalS3ExportRead(ctx, d, meta)...)
+}
+
+func resourceJournalS3ExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
+
+	ledgerName := d.Get("ledger_name").(string)
+	export, err := findJournalS3ExportByTwoPartKey(ctx, conn, ledgerName, d.Id())
+
+	// QLDB only describes an export for a limited period after it completes, but the exported objects remain in S3.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QLDB Journal S3 Export (%s) can no longer be described, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QLDB Journal S3 Export (%s): %s", d.Id(), err)
+	}
+
+	// The configured time range is kept as QLDB returns it in UTC and clamps it to the ledger's lifetime.
+	if export.ExportCreationTime != nil {
+		d.Set("export_creation_time", aws.ToTime(export.ExportCreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("export_creation_time", nil)
+	}
+	d.Set("ledger_name", export.LedgerName)
+	d.Set("output_format", export.OutputFormat)
+	d.Set(names.AttrRoleARN, export.RoleArn)
+	if export.S3ExportConfiguration != nil {
+		if err := d.Set("s3_export_configuration", []interface{}{flattenS3ExportConfiguration(export.S3ExportConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3_export_configuration: %s", err)
+		}
+	} else {
+		d.Set("s3_export_configuration", nil)
+	}
+	d.Set(names.AttrStatus, export.Status)
+
+	return diags
+}
+
+func resourceJournalS3ExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Journal exports cannot be deleted; the exported objects remain in the S3 bucket.
+	log.Printf("[WARN] QLDB Journal S3 Export (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findJournalS3ExportByTwoPartKey(ctx context.Context, conn *qldb.Client, ledgerName, exportID string) (*types.JournalS3ExportDescription, error) {
+	input := &qldb.DescribeJournalS3ExportInput{
+		ExportId: aws.String(exportID),
+		Name:     aws.String(ledgerName),
+	}
+
+	output, err := conn.DescribeJournalS3Export(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
+
+func statusJournalS3Export(ctx context.Context, conn *qldb.Client, ledgerName, exportID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJournalS3ExportByTwoPartKey(ctx, conn, ledgerName, exportID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJournalS3ExportCompleted(ctx context.Context, conn *qldb.Client, ledgerName, exportID string, timeout time.Duration) (*types.JournalS3ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ExportStatusInProgress),
+		Target:     enum.Slice(types.ExportStatusCompleted),
+		Refresh:    statusJournalS3Export(ctx, conn, ledgerName, exportID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JournalS3ExportDescription); ok {
+		if output.Status == types.ExportStatusCancelled {
+			tfresource.SetLastError(err, fmt.Errorf("export %s", output.Status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandS3ExportConfiguration(tfMap map[string]interface{}) *types.S3ExportConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ExportConfiguration{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEncryptionConfiguration].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionConfiguration = expandS3EncryptionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3EncryptionConfiguration(tfMap map[string]interface{}) *types.S3EncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3EncryptionConfiguration{}
+
+	if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_encryption_type"].(string); ok && v != "" {
+		apiObject.ObjectEncryptionType = types.S3ObjectEncryptionType(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3ExportConfiguration(apiObject *types.S3ExportConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap[names.AttrBucket] = aws.ToString(v)
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap[names.AttrEncryptionConfiguration] = []interface{}{flattenS3EncryptionConfiguration(v)}
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap[names.AttrPrefix] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3EncryptionConfiguration(apiObject *types.S3EncryptionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"object_encryption_type": apiObject.ObjectEncryptionType,
+	}
+
+	if v := apiObject.KmsKeyArn; v != nil {
+		tfMap[names.AttrKMSKeyARN] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qldb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqldb "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQLDBJournalS3Export_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JournalS3ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_journal_s3_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJournalS3ExportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJournalS3ExportExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "export_creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "inclusive_start_time", "2021-01-01T09:00:00+09:00"),
+					resource.TestCheckResourceAttrPair(resourceName, "ledger_name", "aws_qldb_ledger.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "ION_TEXT"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_export_configuration.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.0.object_encryption_type", "SSE_S3"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.prefix", "exports/"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQLDBJournalS3Export_kms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JournalS3ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_journal_s3_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJournalS3ExportConfig_kms(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJournalS3ExportExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.0.object_encryption_type", "SSE_KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_export_configuration.0.encryption_configuration.0.kms_key_arn", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJournalS3ExportExists(ctx context.Context, n string, v *types.JournalS3ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBClient(ctx)
+
+		output, err := tfqldb.FindJournalS3ExportByTwoPartKey(ctx, conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJournalS3ExportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "ALLOW_ALL"
+  deletion_protection = false
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qldb.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:PutObject",
+        "s3:PutObjectAcl",
+      ]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJournalS3ExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJournalS3ExportConfig_base(rName), `
+resource "aws_qldb_journal_s3_export" "test" {
+  ledger_name          = aws_qldb_ledger.test.name
+  inclusive_start_time = "2021-01-01T09:00:00+09:00"
+  exclusive_end_time   = timestamp()
+  role_arn             = aws_iam_role.test.arn
+
+  s3_export_configuration {
+    bucket = aws_s3_bucket.test.bucket
+    prefix = "exports/"
+
+    encryption_configuration {
+      object_encryption_type = "SSE_S3"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+
+  # timestamp() changes on every plan.
+  lifecycle {
+    ignore_changes = [exclusive_end_time]
+  }
+}
+`)
+}
+
+func testAccJournalS3ExportConfig_kms(rName string) string {
+	return acctest.ConfigCompose(testAccJournalS3ExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_iam_role_policy" "kms" {
+  name = "%[1]s-kms"
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "kms:GenerateDataKey",
+      ]
+      Effect   = "Allow"
+      Resource = aws_kms_key.test.arn
+    }]
+  })
+}
+
+resource "aws_qldb_journal_s3_export" "test" {
+  ledger_name          = aws_qldb_ledger.test.name
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+  exclusive_end_time   = timestamp()
+  output_format        = "JSON"
+  role_arn             = aws_iam_role.test.arn
+
+  s3_export_configuration {
+    bucket = aws_s3_bucket.test.bucket
+    prefix = "exports/"
+
+    encryption_configuration {
+      kms_key_arn            = aws_kms_key.test.arn
+      object_encryption_type = "SSE_KMS"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy.kms]
+
+  # timestamp() changes on every plan.
+  lifecycle {
+    ignore_changes = [exclusive_end_time]
+  }
+}
+`, rName))
+}
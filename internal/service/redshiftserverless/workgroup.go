@@ -475,7 +475,12 @@ func resourceWorkgroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 func updateWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServerless, input *redshiftserverless.UpdateWorkgroupInput, timeout time.Duration) error {
 	name := aws.StringValue(input.WorkgroupName)
-	_, err := conn.UpdateWorkgroupWithContext(ctx, input)
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.UpdateWorkgroupWithContext(ctx, input)
+		},
+		// "ConflictException: There is an operation running on the workgroup. Try updating the workgroup again later."
+		redshiftserverless.ErrCodeConflictException, "operation running")
 
 	if err != nil {
 		return fmt.Errorf("updating Redshift Serverless Workgroup (%s): %w", name, err)
@@ -535,6 +540,9 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Redshi
 		Target:  []string{redshiftserverless.WorkgroupStatusAvailable},
 		Refresh: statusWorkgroup(ctx, conn, name),
 		Timeout: wait,
+		// Changes that only affect config parameters may not move the workgroup out of AVAILABLE straight away.
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                5 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
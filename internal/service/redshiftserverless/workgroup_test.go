@@ -319,6 +319,45 @@ func TestAccRedshiftServerlessWorkgroup_port(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftServerlessWorkgroup_configParametersAndPort(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkgroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfig_configParametersAndPort(rName, "14400", 5439),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "config_parameter.*", map[string]string{
+						"parameter_key":   "max_query_execution_time",
+						"parameter_value": "14400",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "5439"),
+				),
+			},
+			{
+				// Changing config parameters and another attribute in the same apply requires
+				// waiting for the first update to finish before issuing the second.
+				Config: testAccWorkgroupConfig_configParametersAndPort(rName, "28800", 8191),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "config_parameter.*", map[string]string{
+						"parameter_key":   "max_query_execution_time",
+						"parameter_value": "28800",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "8191"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckWorkgroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
@@ -504,3 +543,54 @@ resource "aws_redshiftserverless_workgroup" "test" {
 }
 `, rName, port)
 }
+
+func testAccWorkgroupConfig_configParametersAndPort(rName, maxQueryExecutionTime string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+  port           = %[3]d
+
+  config_parameter {
+    parameter_key   = "datestyle"
+    parameter_value = "ISO, MDY"
+  }
+  config_parameter {
+    parameter_key   = "enable_user_activity_logging"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "query_group"
+    parameter_value = "default"
+  }
+  config_parameter {
+    parameter_key   = "search_path"
+    parameter_value = "$user, public"
+  }
+  config_parameter {
+    parameter_key   = "max_query_execution_time"
+    parameter_value = %[2]q
+  }
+  config_parameter {
+    parameter_key   = "auto_mv"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "enable_case_sensitive_identifier"
+    parameter_value = "false"
+  }
+  config_parameter {
+    parameter_key   = "require_ssl"
+    parameter_value = "false"
+  }
+  config_parameter {
+    parameter_key   = "use_fips_ssl"
+    parameter_value = "false"
+  }
+}
+`, rName, maxQueryExecutionTime, port)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Default View")
+func newDataSourceDefaultView(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceDefaultView{}, nil
+}
+
+const (
+	DSNameDefaultView = "Default View Data Source"
+)
+
+type dataSourceDefaultView struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceDefaultView) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_resourceexplorer2_default_view"
+}
+
+func (d *dataSourceDefaultView) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceDefaultView) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	var data dataSourceDefaultViewData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.Meta().Region
+
+	arn, err := findDefaultViewARN(ctx, conn)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ResourceExplorer2, create.ErrActionReading, DSNameDefaultView, region, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(region)
+	// An empty ARN indicates that no default view is set in the Region.
+	if arn == "" {
+		data.ViewARN = fwtypes.ARNNull()
+	} else {
+		data.ViewARN = fwtypes.ARNValue(arn)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceDefaultViewData struct {
+	ID      types.String `tfsdk:"id"`
+	ViewARN fwtypes.ARN  `tfsdk:"view_arn"`
+}
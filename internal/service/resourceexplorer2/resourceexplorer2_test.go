@@ -26,6 +26,9 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 			"filter":             testAccView_filter,
 			"tags":               testAccView_tags,
 		},
+		"DefaultViewDataSource": {
+			acctest.CtBasic: testAccDefaultViewDataSource_basic,
+		},
 		"SearchDataSource": {
 			acctest.CtBasic: testAccSearchDataSource_basic,
 			"indexType":     testAccSearchDataSource_IndexType,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,6 +39,11 @@ func dataSourceResources() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"tag_filter"},
 			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"resource_type_filters": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -144,6 +150,10 @@ func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
+	d.Set("resource_arns", tfslices.ApplyToAll(taggings, func(v types.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	}))
+
 	if err := d.Set("resource_tag_mapping_list", flattenResourceTagMappings(ctx, taggings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting resource tag mapping list: %s", err)
 	}
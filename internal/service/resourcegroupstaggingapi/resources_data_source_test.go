@@ -31,6 +31,8 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_tagFilter(t *testing.T)
 						"tags.Key": rName,
 					}),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", resourceName, names.AttrARN),
 				),
 			},
 		},
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53domains
+
+type acquisitionMode string
+
+const (
+	acquisitionModeNone     acquisitionMode = "NONE"
+	acquisitionModeRegister acquisitionMode = "REGISTER"
+	acquisitionModeTransfer acquisitionMode = "TRANSFER"
+)
+
+func (acquisitionMode) Values() []acquisitionMode {
+	return []acquisitionMode{
+		acquisitionModeNone,
+		acquisitionModeRegister,
+		acquisitionModeTransfer,
+	}
+}
@@ -8,5 +8,16 @@ var (
 	ResourceDelegationSignerRecord = newDelegationSignerRecordResource
 	ResourceRegisteredDomain       = resourceRegisteredDomain
 
-	FindDNSSECKeyByTwoPartKey = findDNSSECKeyByTwoPartKey
+	AcquisitionModeDeletesDomain  = acquisitionModeDeletesDomain
+	FindDNSSECKeyByTwoPartKey     = findDNSSECKeyByTwoPartKey
+	RenewalDurationInYears        = renewalDurationInYears
+	ValidateDurationInYearsChange = validateDurationInYearsChange
+)
+
+type AcquisitionMode = acquisitionMode
+
+const (
+	AcquisitionModeNone     = acquisitionModeNone
+	AcquisitionModeRegister = acquisitionModeRegister
+	AcquisitionModeTransfer = acquisitionModeTransfer
 )
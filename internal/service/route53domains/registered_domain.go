@@ -16,10 +16,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -35,15 +37,20 @@ func resourceRegisteredDomain() *schema.Resource {
 		CreateWithoutTimeout: resourceRegisteredDomainCreate,
 		ReadWithoutTimeout:   resourceRegisteredDomainRead,
 		UpdateWithoutTimeout: resourceRegisteredDomainUpdate,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceRegisteredDomainDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("acquisition_mode", string(acquisitionModeNone))
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -153,12 +160,26 @@ func resourceRegisteredDomain() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"acquisition_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          string(acquisitionModeNone),
+					ValidateDiagFunc: enum.Validate[acquisitionMode](),
+					// The value recorded at creation determines whether the domain is deleted on destroy.
+					DiffSuppressFunc: suppressAcquisitionModeDiffAfterCreate,
+				},
 				"admin_contact": contactSchema(),
 				"admin_privacy": {
 					Type:     schema.TypeBool,
 					Optional: true,
 					Default:  true,
 				},
+				"auth_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
 				"auto_renew": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -178,6 +199,12 @@ func resourceRegisteredDomain() *schema.Resource {
 					Type:     schema.TypeString,
 					Required: true,
 				},
+				"duration_in_years": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 10),
+				},
 				"expiration_date": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -245,6 +272,10 @@ func resourceRegisteredDomain() *schema.Resource {
 					Optional: true,
 					Default:  true,
 				},
+				"transfer_operation_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"updated_date": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -256,7 +287,12 @@ func resourceRegisteredDomain() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ValidateChange("duration_in_years", func(_ context.Context, old, new, meta interface{}) error {
+				return validateDurationInYearsChange(old.(int), new.(int))
+			}),
+		),
 	}
 }
 
@@ -265,8 +301,36 @@ func resourceRegisteredDomainCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
 
 	domainName := d.Get(names.AttrDomainName).(string)
+
+	switch acquisitionMode(d.Get("acquisition_mode").(string)) {
+	case acquisitionModeRegister:
+		d.Set("duration_in_years", registrationDurationInYears(d))
+
+		if err := registerDomain(ctx, conn, d, domainName, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	case acquisitionModeTransfer:
+		d.Set("duration_in_years", registrationDurationInYears(d))
+
+		operationID, err := transferDomain(ctx, conn, d, domainName, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("transfer_operation_id", operationID)
+	}
+
 	domainDetail, err := findDomainDetailByName(ctx, conn, domainName)
 
+	// Transfers from another registrar can take several days to complete.
+	// The remaining settings are applied by a later apply once the domain is in the account.
+	if tfresource.NotFound(err) && d.Get("transfer_operation_id").(string) != "" {
+		d.SetId(domainName)
+
+		return append(diags, resourceRegisteredDomainRead(ctx, d, meta)...)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Domains Domain (%s): %s", domainName, err)
 	}
@@ -358,6 +422,24 @@ func resourceRegisteredDomainRead(ctx context.Context, d *schema.ResourceData, m
 
 	domainDetail, err := findDomainDetailByName(ctx, conn, d.Id())
 
+	if tfresource.NotFound(err) {
+		if operationID := d.Get("transfer_operation_id").(string); operationID != "" {
+			pending, opErr := isTransferPending(ctx, conn, operationID)
+
+			if opErr != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Route 53 Domains Domain (%s) transfer operation (%s): %s", d.Id(), operationID, opErr)
+			}
+
+			if pending {
+				log.Printf("[DEBUG] Route 53 Domains Domain (%s) transfer (%s) in progress", d.Id(), operationID)
+				// The domain's tags cannot be listed until the transfer completes.
+				setTagsOut(ctx, Tags(tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{}))))
+
+				return diags
+			}
+		}
+	}
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route 53 Domains Domain %s not found, removing from state", d.Id())
 		d.SetId("")
@@ -475,6 +557,15 @@ func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("duration_in_years") {
+		o, n := d.GetChange("duration_in_years")
+		if v := renewalDurationInYears(acquisitionMode(d.Get("acquisition_mode").(string)), o.(int), n.(int)); v > 0 {
+			if err := renewDomain(ctx, conn, d.Id(), int32(v), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.HasChange("auto_renew") {
 		if err := modifyDomainAutoRenew(ctx, conn, d.Id(), d.Get("auto_renew").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -498,6 +589,228 @@ func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData,
 	return append(diags, resourceRegisteredDomainRead(ctx, d, meta)...)
 }
 
+func resourceRegisteredDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
+
+	// Adopted domains are only removed from state.
+	if !acquisitionModeDeletesDomain(acquisitionMode(d.Get("acquisition_mode").(string))) {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Domains Domain: %s", d.Id())
+	output, err := conn.DeleteDomain(ctx, &route53domains.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if errs.IsAErrorMessageContains[*types.InvalidInput](err, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Domains Domain (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Domains Domain (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func suppressAcquisitionModeDiffAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// validateDurationInYearsChange returns an error if duration_in_years is decreased.
+// A zero old value is state recorded before duration_in_years was added, not a registration period.
+func validateDurationInYearsChange(o, n int) error {
+	if o != 0 && n < o {
+		return fmt.Errorf("duration_in_years cannot be decreased (%d to %d); a domain's registration period can only be extended", o, n)
+	}
+
+	return nil
+}
+
+// registrationDurationInYears returns the number of years for which to register or transfer the domain.
+func registrationDurationInYears(d *schema.ResourceData) int {
+	if v, ok := d.GetOk("duration_in_years"); ok {
+		return v.(int)
+	}
+
+	return 1
+}
+
+// renewalDurationInYears returns the number of years for which to renew the domain when duration_in_years changes, or 0.
+// Renewals are charged to the account, so only domains registered or transferred by this resource are renewed.
+// A zero old value is state recorded before duration_in_years was added, or an imported domain, so the first change only records the value.
+func renewalDurationInYears(mode acquisitionMode, o, n int) int {
+	if !acquisitionModeDeletesDomain(mode) || o == 0 || n <= o {
+		return 0
+	}
+
+	return n - o
+}
+
+// acquisitionModeDeletesDomain returns whether destroying the resource deletes the domain.
+// Only domains registered or transferred by this resource are deleted. Adopted domains,
+// including those managed before acquisition_mode was added (an empty value), are not.
+func acquisitionModeDeletesDomain(mode acquisitionMode) bool {
+	switch mode {
+	case acquisitionModeRegister, acquisitionModeTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+func expandRegistrationContacts(d *schema.ResourceData) (adminContact, billingContact, registrantContact, techContact *types.ContactDetail, err error) {
+	for _, v := range []struct {
+		key     string
+		contact **types.ContactDetail
+	}{
+		{"admin_contact", &adminContact},
+		{"billing_contact", &billingContact},
+		{"registrant_contact", &registrantContact},
+		{"tech_contact", &techContact},
+	} {
+		if tfList, ok := d.GetOk(v.key); ok && len(tfList.([]interface{})) > 0 && tfList.([]interface{})[0] != nil {
+			*v.contact = expandContactDetail(tfList.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if adminContact == nil || registrantContact == nil || techContact == nil {
+		return nil, nil, nil, nil, fmt.Errorf("admin_contact, registrant_contact and tech_contact are required when acquisition_mode is %s or %s", acquisitionModeRegister, acquisitionModeTransfer)
+	}
+
+	return adminContact, billingContact, registrantContact, techContact, nil
+}
+
+func registerDomain(ctx context.Context, conn *route53domains.Client, d *schema.ResourceData, domainName string, timeout time.Duration) error {
+	adminContact, billingContact, registrantContact, techContact, err := expandRegistrationContacts(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &route53domains.RegisterDomainInput{
+		AdminContact:                    adminContact,
+		AutoRenew:                       aws.Bool(d.Get("auto_renew").(bool)),
+		BillingContact:                  billingContact,
+		DomainName:                      aws.String(domainName),
+		DurationInYears:                 aws.Int32(int32(d.Get("duration_in_years").(int))),
+		PrivacyProtectAdminContact:      aws.Bool(d.Get("admin_privacy").(bool)),
+		PrivacyProtectBillingContact:    aws.Bool(d.Get("billing_privacy").(bool)),
+		PrivacyProtectRegistrantContact: aws.Bool(d.Get("registrant_privacy").(bool)),
+		PrivacyProtectTechContact:       aws.Bool(d.Get("tech_privacy").(bool)),
+		RegistrantContact:               registrantContact,
+		TechContact:                     techContact,
+	}
+
+	output, err := conn.RegisterDomain(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 Domains Domain (%s) registration: %w", domainName, err)
+	}
+
+	return nil
+}
+
+// transferDomain starts a domain transfer and returns its operation ID once the transfer request has been accepted.
+// It does not wait for the transfer to complete.
+func transferDomain(ctx context.Context, conn *route53domains.Client, d *schema.ResourceData, domainName string, timeout time.Duration) (string, error) {
+	adminContact, billingContact, registrantContact, techContact, err := expandRegistrationContacts(d)
+
+	if err != nil {
+		return "", err
+	}
+
+	input := &route53domains.TransferDomainInput{
+		AdminContact:                    adminContact,
+		AutoRenew:                       aws.Bool(d.Get("auto_renew").(bool)),
+		BillingContact:                  billingContact,
+		DomainName:                      aws.String(domainName),
+		DurationInYears:                 aws.Int32(int32(d.Get("duration_in_years").(int))),
+		PrivacyProtectAdminContact:      aws.Bool(d.Get("admin_privacy").(bool)),
+		PrivacyProtectBillingContact:    aws.Bool(d.Get("billing_privacy").(bool)),
+		PrivacyProtectRegistrantContact: aws.Bool(d.Get("registrant_privacy").(bool)),
+		PrivacyProtectTechContact:       aws.Bool(d.Get("tech_privacy").(bool)),
+		RegistrantContact:               registrantContact,
+		TechContact:                     techContact,
+	}
+
+	if v, ok := d.GetOk("auth_code"); ok {
+		input.AuthCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		input.Nameservers = expandNameservers(v.([]interface{}))
+	}
+
+	output, err := conn.TransferDomain(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("transferring Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	operationID := aws.ToString(output.OperationId)
+
+	if _, err := waitOperationAccepted(ctx, conn, operationID, timeout); err != nil {
+		return "", fmt.Errorf("waiting for Route 53 Domains Domain (%s) transfer to start: %w", domainName, err)
+	}
+
+	return operationID, nil
+}
+
+func isTransferPending(ctx context.Context, conn *route53domains.Client, operationID string) (bool, error) {
+	output, err := findOperationDetailByID(ctx, conn, operationID)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	switch output.Status {
+	case types.OperationStatusSubmitted, types.OperationStatusInProgress:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func renewDomain(ctx context.Context, conn *route53domains.Client, domainName string, durationInYears int32, timeout time.Duration) error {
+	domainDetail, err := findDomainDetailByName(ctx, conn, domainName)
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	input := &route53domains.RenewDomainInput{
+		CurrentExpiryYear: int32(aws.ToTime(domainDetail.ExpirationDate).Year()),
+		DomainName:        aws.String(domainName),
+		DurationInYears:   aws.Int32(durationInYears),
+	}
+
+	output, err := conn.RenewDomain(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("renewing Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 Domains Domain (%s) renewal: %w", domainName, err)
+	}
+
+	return nil
+}
+
 func hasDomainTransferLock(statusList []string) bool {
 	const (
 		eppStatusClientTransferProhibited = "clientTransferProhibited"
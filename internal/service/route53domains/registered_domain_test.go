@@ -7,11 +7,141 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateDurationInYearsChange(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new int
+		wantErr  bool
+	}{
+		"unchanged":         {old: 2, new: 2},
+		"increased":         {old: 1, new: 3},
+		"decreased":         {old: 3, new: 1, wantErr: true},
+		"pre-upgrade state": {old: 0, new: 1},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfroute53domains.ValidateDurationInYearsChange(testCase.old, testCase.new)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidateDurationInYearsChange(%d, %d) error = %v, wantErr %t", testCase.old, testCase.new, err, want)
+			}
+		})
+	}
+}
+
+func TestRenewalDurationInYears(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mode     tfroute53domains.AcquisitionMode
+		old, new int
+		want     int
+	}{
+		"unchanged":           {mode: tfroute53domains.AcquisitionModeRegister, old: 2, new: 2, want: 0},
+		"increased, register": {mode: tfroute53domains.AcquisitionModeRegister, old: 1, new: 3, want: 2},
+		"increased, transfer": {mode: tfroute53domains.AcquisitionModeTransfer, old: 1, new: 2, want: 1},
+		"increased, adopted":  {mode: tfroute53domains.AcquisitionModeNone, old: 1, new: 3, want: 0},
+		"increased, no mode":  {mode: "", old: 1, new: 3, want: 0},
+		"decreased":           {mode: tfroute53domains.AcquisitionModeRegister, old: 3, new: 1, want: 0},
+		"pre-upgrade state":   {mode: tfroute53domains.AcquisitionModeRegister, old: 0, new: 1, want: 0},
+		"imported, first set": {mode: tfroute53domains.AcquisitionModeNone, old: 0, new: 2, want: 0},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfroute53domains.RenewalDurationInYears(testCase.mode, testCase.old, testCase.new); got != testCase.want {
+				t.Errorf("RenewalDurationInYears(%q, %d, %d) = %d, want %d", testCase.mode, testCase.old, testCase.new, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAcquisitionModeDeletesDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mode tfroute53domains.AcquisitionMode
+		want bool
+	}{
+		"pre-upgrade state": {mode: "", want: false},
+		"NONE":              {mode: tfroute53domains.AcquisitionModeNone, want: false},
+		"REGISTER":          {mode: tfroute53domains.AcquisitionModeRegister, want: true},
+		"TRANSFER":          {mode: tfroute53domains.AcquisitionModeTransfer, want: true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfroute53domains.AcquisitionModeDeletesDomain(testCase.mode); got != testCase.want {
+				t.Errorf("AcquisitionModeDeletesDomain(%q) = %t, want %t", testCase.mode, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRegisteredDomainAcquisitionModeDiffSuppress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id   string
+		old  string
+		new  string
+		want bool
+	}{
+		"create": {
+			old:  "",
+			new:  "REGISTER",
+			want: false,
+		},
+		"changed after create": {
+			id:   "example.com",
+			old:  "NONE",
+			new:  "REGISTER",
+			want: true,
+		},
+		"pre-upgrade state": {
+			id:   "example.com",
+			old:  "",
+			new:  "NONE",
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tfroute53domains.ResourceRegisteredDomain()
+			d := schema.TestResourceDataRaw(t, r.SchemaMap(), map[string]interface{}{
+				names.AttrDomainName: "example.com",
+			})
+			d.SetId(testCase.id)
+
+			if got := r.SchemaMap()["acquisition_mode"].DiffSuppressFunc("acquisition_mode", testCase.old, testCase.new, d); got != testCase.want {
+				t.Errorf("DiffSuppressFunc(%q, %q) with ID %q = %t, want %t", testCase.old, testCase.new, testCase.id, got, testCase.want)
+			}
+		})
+	}
+}
+
 func testAccRegisteredDomain_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_DOMAIN_NAME")
@@ -307,6 +437,58 @@ func testAccRegisteredDomain_transferLock(t *testing.T) {
 	})
 }
 
+// testAccRegisteredDomain_register registers a new domain, which is billed and can't always be undone.
+// It is skipped unless ROUTE53DOMAINS_REGISTER_DOMAIN_NAME is set to an available domain name.
+func testAccRegisteredDomain_register(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_REGISTER_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfig_acquisitionMode(domainName, "REGISTER", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acquisition_mode", "REGISTER"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, domainName),
+					resource.TestCheckResourceAttr(resourceName, "duration_in_years", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRegisteredDomain_transfer transfers a domain from another registrar, which is billed.
+// It is skipped unless ROUTE53DOMAINS_TRANSFER_DOMAIN_NAME and ROUTE53DOMAINS_TRANSFER_AUTH_CODE are set.
+func testAccRegisteredDomain_transfer(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_TRANSFER_DOMAIN_NAME")
+	authCode := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_TRANSFER_AUTH_CODE")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfig_acquisitionMode(domainName, "TRANSFER", authCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acquisition_mode", "TRANSFER"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_operation_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRegisteredDomainConfig_tags1(domainName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_route53domains_registered_domain" "test" {
@@ -537,3 +719,61 @@ resource "aws_route53domains_registered_domain" "test" {
 }
 `, domainName, transferLock)
 }
+
+func testAccRegisteredDomainConfig_acquisitionMode(domainName, acquisitionMode, authCode string) string {
+	// auth_code must be non-empty when set, so leave it out of the configuration for registrations.
+	var authCodeAttribute string
+	if authCode != "" {
+		authCodeAttribute = fmt.Sprintf("auth_code        = %q", authCode)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name      = %[1]q
+  acquisition_mode = %[2]q
+  %[3]s
+
+  admin_contact {
+    address_line_1    = "100 Main Street"
+    city              = "New York City"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = "terraform-acctest+aws-route53domains-test1@hashicorp.com"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.2025551234"
+    state             = "NY"
+    zip_code          = "10001"
+  }
+
+  registrant_contact {
+    address_line_1    = "100 Main Street"
+    city              = "New York City"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = "terraform-acctest+aws-route53domains-test2@hashicorp.com"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.2025551234"
+    state             = "NY"
+    zip_code          = "10001"
+  }
+
+  tech_contact {
+    address_line_1    = "100 Main Street"
+    city              = "New York City"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = "terraform-acctest+aws-route53domains-test3@hashicorp.com"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.2025551234"
+    state             = "NY"
+    zip_code          = "10001"
+  }
+}
+`, domainName, acquisitionMode, authCodeAttribute)
+}
@@ -24,6 +24,8 @@ func TestAccRoute53Domains_serial(t *testing.T) {
 			"contactPrivacy": testAccRegisteredDomain_contactPrivacy,
 			"nameservers":    testAccRegisteredDomain_nameservers,
 			"transferLock":   testAccRegisteredDomain_transferLock,
+			"register":       testAccRegisteredDomain_register,
+			"transfer":       testAccRegisteredDomain_transfer,
 		},
 		"DelegationSignerRecord": {
 			acctest.CtBasic:      testAccDelegationSignerRecord_basic,
@@ -36,6 +36,25 @@ func waitOperationSucceeded(ctx context.Context, conn *route53domains.Client, id
 	return nil, err
 }
 
+func waitOperationAccepted(ctx context.Context, conn *route53domains.Client, id string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.OperationStatusSubmitted),
+		Target:  enum.Slice(types.OperationStatusInProgress, types.OperationStatusSuccessful),
+		Timeout: timeout,
+		Refresh: statusOperation(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func statusOperation(ctx context.Context, conn *route53domains.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findOperationDetailByID(ctx, conn, id)
@@ -114,6 +114,42 @@ func TestAccRoute53ResolverFirewallRule_blockOverride(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallRule_qType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_qType(rName, "AAAA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "q_type", "AAAA"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_redirection_action", "TRUST_REDIRECTION_DOMAIN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallRuleConfig_qType(rName, "MX"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "q_type", "MX"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53resolver.FirewallRule
@@ -147,13 +183,13 @@ func testAccCheckFirewallRuleDestroy(ctx context.Context) resource.TestCheckFunc
 				continue
 			}
 
-			firewallRuleGroupID, firewallDomainListID, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
+			firewallRuleGroupID, firewallDomainListID, qType, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
 
 			if err != nil {
 				return err
 			}
 
-			_, err = tfroute53resolver.FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+			_, err = tfroute53resolver.FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID, qType)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -181,7 +217,7 @@ func testAccCheckFirewallRuleExists(ctx context.Context, n string, v *route53res
 			return fmt.Errorf("No Route53 Resolver Firewall Rule ID is set")
 		}
 
-		firewallRuleGroupID, firewallDomainListID, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
+		firewallRuleGroupID, firewallDomainListID, qType, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -189,7 +225,7 @@ func testAccCheckFirewallRuleExists(ctx context.Context, n string, v *route53res
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
 
-		output, err := tfroute53resolver.FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+		output, err := tfroute53resolver.FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID, qType)
 
 		if err != nil {
 			return err
@@ -265,3 +301,25 @@ resource "aws_route53_resolver_firewall_rule" "test" {
 }
 `, rName)
 }
+
+func testAccFirewallRuleConfig_qType(rName, qType string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                               = %[1]q
+  action                             = "ALLOW"
+  firewall_domain_redirection_action = "TRUST_REDIRECTION_DOMAIN"
+  firewall_rule_group_id             = aws_route53_resolver_firewall_rule_group.test.id
+  firewall_domain_list_id            = aws_route53_resolver_firewall_domain_list.test.id
+  priority                           = 100
+  q_type                             = %[2]q
+}
+`, rName, qType)
+}
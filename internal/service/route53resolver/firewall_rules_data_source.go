@@ -66,6 +66,10 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"firewall_domain_redirection_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"firewall_rule_group_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -82,6 +86,10 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"q_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -177,6 +185,9 @@ func flattenFirewallRule(apiObject *route53resolver.FirewallRule) map[string]int
 	if apiObject.FirewallDomainListId != nil {
 		tfMap["firewall_domain_list_id"] = aws.StringValue(apiObject.FirewallDomainListId)
 	}
+	if apiObject.FirewallDomainRedirectionAction != nil {
+		tfMap["firewall_domain_redirection_action"] = aws.StringValue(apiObject.FirewallDomainRedirectionAction)
+	}
 	if apiObject.FirewallRuleGroupId != nil {
 		tfMap["firewall_rule_group_id"] = aws.StringValue(apiObject.FirewallRuleGroupId)
 	}
@@ -189,5 +200,8 @@ func flattenFirewallRule(apiObject *route53resolver.FirewallRule) map[string]int
 	if apiObject.Priority != nil {
 		tfMap[names.AttrPriority] = aws.Int64Value(apiObject.Priority)
 	}
+	if apiObject.Qtype != nil {
+		tfMap["q_type"] = aws.StringValue(apiObject.Qtype)
+	}
 	return tfMap
 }
@@ -401,7 +401,7 @@ func sweepFirewallRules(region string) error {
 				for _, v := range page.FirewallRules {
 					r := ResourceFirewallRule()
 					d := r.Data(nil)
-					d.SetId(FirewallRuleCreateResourceID(aws.StringValue(v.FirewallRuleGroupId), aws.StringValue(v.FirewallDomainListId)))
+					d.SetId(FirewallRuleCreateResourceID(aws.StringValue(v.FirewallRuleGroupId), aws.StringValue(v.FirewallDomainListId), aws.StringValue(v.Qtype)))
 
 					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
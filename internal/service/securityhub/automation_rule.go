@@ -78,109 +78,114 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
 		Blocks: map[string]schema.Block{
-			names.AttrActions: schema.SetNestedBlock{
-				CustomType: fwtypes.NewSetNestedObjectTypeOf[automationRulesActionModel](ctx),
-				Validators: []validator.Set{
-					setvalidator.IsRequired(),
-					setvalidator.SizeAtLeast(1),
+			names.AttrActions: automationRuleActionsSchemaFramework(ctx),
+			"criteria":        automationRuleCriteriaSchemaFramework(ctx),
+		},
+	}
+}
+
+func automationRuleActionsSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[automationRulesActionModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.IsRequired(),
+			setvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.AutomationRulesActionType](),
+					Optional:   true,
 				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						names.AttrType: schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.AutomationRulesActionType](),
-							Optional:   true,
-						},
+			},
+			Blocks: map[string]schema.Block{
+				"finding_fields_update": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFieldsUpdateModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
 					},
-					Blocks: map[string]schema.Block{
-						"finding_fields_update": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFieldsUpdateModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"confidence": schema.Int64Attribute{
+								Optional: true,
 							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"confidence": schema.Int64Attribute{
-										Optional: true,
-									},
-									"criticality": schema.Int64Attribute{
-										Optional: true,
-									},
-									"types": schema.ListAttribute{
-										CustomType:  fwtypes.ListOfStringType,
-										Optional:    true,
-										ElementType: types.StringType,
-									},
-									"user_defined_fields": schema.MapAttribute{
-										CustomType:  fwtypes.MapOfStringType,
-										Optional:    true,
-										ElementType: types.StringType,
-									},
-									"verification_state": schema.StringAttribute{
-										CustomType: fwtypes.StringEnumType[awstypes.VerificationState](),
-										Optional:   true,
-									},
+							"criticality": schema.Int64Attribute{
+								Optional: true,
+							},
+							"types": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"user_defined_fields": schema.MapAttribute{
+								CustomType:  fwtypes.MapOfStringType,
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"verification_state": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.VerificationState](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"note": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[noteUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
 								},
-								Blocks: map[string]schema.Block{
-									"note": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[noteUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"text": schema.StringAttribute{
+											Required: true,
 										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"text": schema.StringAttribute{
-													Required: true,
-												},
-												"updated_by": schema.StringAttribute{
-													Required: true,
-												},
-											},
+										"updated_by": schema.StringAttribute{
+											Required: true,
 										},
 									},
-									"related_findings": schema.SetNestedBlock{
-										CustomType: fwtypes.NewSetNestedObjectTypeOf[relatedFindingModel](ctx),
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												names.AttrID: schema.StringAttribute{
-													Required: true,
-												},
-												"product_arn": schema.StringAttribute{
-													CustomType: fwtypes.ARNType,
-													Required:   true,
-												},
-											},
+								},
+							},
+							"related_findings": schema.SetNestedBlock{
+								CustomType: fwtypes.NewSetNestedObjectTypeOf[relatedFindingModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrID: schema.StringAttribute{
+											Required: true,
+										},
+										"product_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
 										},
 									},
-									"severity": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[severityUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
+								},
+							},
+							"severity": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[severityUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"label": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.SeverityLabel](),
+											Optional:   true,
+											Computed:   true,
 										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"label": schema.StringAttribute{
-													CustomType: fwtypes.StringEnumType[awstypes.SeverityLabel](),
-													Optional:   true,
-													Computed:   true,
-												},
-												"product": schema.Float64Attribute{
-													Optional: true,
-												},
-											},
+										"product": schema.Float64Attribute{
+											Optional: true,
 										},
 									},
-									"workflow": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[workflowUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
-										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												names.AttrStatus: schema.StringAttribute{
-													CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
-													Optional:   true,
-												},
-											},
+								},
+							},
+							"workflow": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[workflowUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrStatus: schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
+											Optional:   true,
 										},
 									},
 								},
@@ -189,55 +194,58 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 					},
 				},
 			},
-			"criteria": schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFiltersModel](ctx),
-				Validators: []validator.List{
-					listvalidator.IsRequired(),
-					listvalidator.SizeAtLeast(1),
-					listvalidator.SizeAtMost(1),
-				},
-				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						names.AttrAWSAccountID:               stringFilterSchemaFramework(ctx),
-						"aws_account_name":                   stringFilterSchemaFramework(ctx),
-						"company_name":                       stringFilterSchemaFramework(ctx),
-						"compliance_associated_standards_id": stringFilterSchemaFramework(ctx),
-						"compliance_security_control_id":     stringFilterSchemaFramework(ctx),
-						"compliance_status":                  stringFilterSchemaFramework(ctx),
-						"confidence":                         numberFilterSchemaFramework(ctx),
-						names.AttrCreatedAt:                  dateFilterSchemaFramework(ctx),
-						"criticality":                        numberFilterSchemaFramework(ctx),
-						names.AttrDescription:                stringFilterSchemaFramework(ctx),
-						"first_observed_at":                  dateFilterSchemaFramework(ctx),
-						"generator_id":                       stringFilterSchemaFramework(ctx),
-						names.AttrID:                         stringFilterSchemaFramework(ctx),
-						"last_observed_at":                   dateFilterSchemaFramework(ctx),
-						"note_text":                          stringFilterSchemaFramework(ctx),
-						"note_updated_at":                    dateFilterSchemaFramework(ctx),
-						"note_updated_by":                    stringFilterSchemaFramework(ctx),
-						"product_arn":                        stringFilterSchemaFramework(ctx),
-						"product_name":                       stringFilterSchemaFramework(ctx),
-						"record_state":                       stringFilterSchemaFramework(ctx),
-						"related_findings_id":                stringFilterSchemaFramework(ctx),
-						"related_findings_product_arn":       stringFilterSchemaFramework(ctx),
-						"resource_application_arn":           stringFilterSchemaFramework(ctx),
-						"resource_application_name":          stringFilterSchemaFramework(ctx),
-						"resource_details_other":             mapFilterSchemaFramework(ctx),
-						names.AttrResourceID:                 stringFilterSchemaFramework(ctx),
-						"resource_partition":                 stringFilterSchemaFramework(ctx),
-						"resource_region":                    stringFilterSchemaFramework(ctx),
-						names.AttrResourceTags:               mapFilterSchemaFramework(ctx),
-						names.AttrResourceType:               stringFilterSchemaFramework(ctx),
-						"severity_label":                     stringFilterSchemaFramework(ctx),
-						"source_url":                         stringFilterSchemaFramework(ctx),
-						"title":                              stringFilterSchemaFramework(ctx),
-						names.AttrType:                       stringFilterSchemaFramework(ctx),
-						"updated_at":                         dateFilterSchemaFramework(ctx),
-						"user_defined_fields":                mapFilterSchemaFramework(ctx),
-						"verification_state":                 stringFilterSchemaFramework(ctx),
-						"workflow_status":                    stringFilterSchemaFramework(ctx),
-					},
-				},
+		},
+	}
+}
+
+func automationRuleCriteriaSchemaFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFiltersModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				names.AttrAWSAccountID:               stringFilterSchemaFramework(ctx),
+				"aws_account_name":                   stringFilterSchemaFramework(ctx),
+				"company_name":                       stringFilterSchemaFramework(ctx),
+				"compliance_associated_standards_id": stringFilterSchemaFramework(ctx),
+				"compliance_security_control_id":     stringFilterSchemaFramework(ctx),
+				"compliance_status":                  stringFilterSchemaFramework(ctx),
+				"confidence":                         numberFilterSchemaFramework(ctx),
+				names.AttrCreatedAt:                  dateFilterSchemaFramework(ctx),
+				"criticality":                        numberFilterSchemaFramework(ctx),
+				names.AttrDescription:                stringFilterSchemaFramework(ctx),
+				"first_observed_at":                  dateFilterSchemaFramework(ctx),
+				"generator_id":                       stringFilterSchemaFramework(ctx),
+				names.AttrID:                         stringFilterSchemaFramework(ctx),
+				"last_observed_at":                   dateFilterSchemaFramework(ctx),
+				"note_text":                          stringFilterSchemaFramework(ctx),
+				"note_updated_at":                    dateFilterSchemaFramework(ctx),
+				"note_updated_by":                    stringFilterSchemaFramework(ctx),
+				"product_arn":                        stringFilterSchemaFramework(ctx),
+				"product_name":                       stringFilterSchemaFramework(ctx),
+				"record_state":                       stringFilterSchemaFramework(ctx),
+				"related_findings_id":                stringFilterSchemaFramework(ctx),
+				"related_findings_product_arn":       stringFilterSchemaFramework(ctx),
+				"resource_application_arn":           stringFilterSchemaFramework(ctx),
+				"resource_application_name":          stringFilterSchemaFramework(ctx),
+				"resource_details_other":             mapFilterSchemaFramework(ctx),
+				names.AttrResourceID:                 stringFilterSchemaFramework(ctx),
+				"resource_partition":                 stringFilterSchemaFramework(ctx),
+				"resource_region":                    stringFilterSchemaFramework(ctx),
+				names.AttrResourceTags:               mapFilterSchemaFramework(ctx),
+				names.AttrResourceType:               stringFilterSchemaFramework(ctx),
+				"severity_label":                     stringFilterSchemaFramework(ctx),
+				"source_url":                         stringFilterSchemaFramework(ctx),
+				"title":                              stringFilterSchemaFramework(ctx),
+				names.AttrType:                       stringFilterSchemaFramework(ctx),
+				"updated_at":                         dateFilterSchemaFramework(ctx),
+				"user_defined_fields":                mapFilterSchemaFramework(ctx),
+				"verification_state":                 stringFilterSchemaFramework(ctx),
+				"workflow_status":                    stringFilterSchemaFramework(ctx),
 			},
 		},
 	}
@@ -0,0 +1,619 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of automation rules per account and Region, and per batch request.
+	automationRulesMaxItems = 100
+)
+
+// @FrameworkResource(name="Automation Rules")
+func newAutomationRulesResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &automationRulesResource{}, nil
+}
+
+type automationRulesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *automationRulesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_automation_rules"
+}
+
+func (r *automationRulesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	ruleStatusType := fwtypes.StringEnumType[awstypes.RuleStatus]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(automationRulesMaxItems),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: framework.ARNAttributeComputedOnly(),
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+						},
+						"is_terminal": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"rule_name": schema.StringAttribute{
+							Required: true,
+						},
+						"rule_status": schema.StringAttribute{
+							CustomType: ruleStatusType,
+							Optional:   true,
+							Computed:   true,
+							Default:    ruleStatusType.AttributeDefault(awstypes.RuleStatusEnabled),
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrActions: automationRuleActionsSchemaFramework(ctx),
+						"criteria":        automationRuleCriteriaSchemaFramework(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *automationRulesResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules are matched to existing automation rules by name.
+	seen := make(map[string]bool)
+	for i, rule := range rules {
+		if rule.RuleName.IsNull() || rule.RuleName.IsUnknown() {
+			continue
+		}
+
+		name := rule.RuleName.ValueString()
+		if seen[name] {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrRule).AtListIndex(i).AtName("rule_name"),
+				"Duplicate Attribute Value",
+				fmt.Sprintf("rule_name %q is used by more than one rule", name),
+			)
+		}
+		seen[name] = true
+	}
+}
+
+func (r *automationRulesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// This resource manages every automation rule in the account and Region.
+	// Existing rules must be adopted explicitly by importing the resource, otherwise their rule order would
+	// collide with the configured rules and they would be deleted on the next apply.
+	existingRuleARNs, err := findAutomationRuleARNs(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Security Hub Automation Rules", err.Error())
+
+		return
+	}
+
+	if n := len(existingRuleARNs); n > 0 {
+		response.Diagnostics.AddError(
+			"creating Security Hub Automation Rules",
+			fmt.Sprintf("%d automation rules already exist in this account and Region. Import this resource to manage the existing rules, or delete them first.", n),
+		)
+
+		return
+	}
+
+	var ruleARNs []string
+	for i, rule := range rules {
+		ruleARN, diags := createAutomationRule(ctx, conn, rule, i)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			// Don't leave behind the rules already created, as they aren't recorded in state.
+			if err := deleteAutomationRules(ctx, conn, ruleARNs); err != nil {
+				response.Diagnostics.AddError("deleting Security Hub Automation Rules after failed create", err.Error())
+			}
+
+			return
+		}
+
+		ruleARNs = append(ruleARNs, ruleARN)
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	response.Diagnostics.Append(data.refreshRules(ctx, conn, ruleARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *automationRulesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	automationRules, err := findAllAutomationRules(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenRules(ctx, automationRules)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *automationRulesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	oldRules, diags := old.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newRules, diags := new.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	oldRuleARNs := make(map[string]string)
+	for _, rule := range oldRules {
+		oldRuleARNs[rule.RuleName.ValueString()] = rule.RuleARN.ValueString()
+	}
+
+	newRuleNames := make(map[string]bool)
+	for _, rule := range newRules {
+		newRuleNames[rule.RuleName.ValueString()] = true
+	}
+
+	// Remove rules that are no longer configured first so that they don't interfere with the new ordering.
+	var deleteRuleARNs []string
+	for name, ruleARN := range oldRuleARNs {
+		if !newRuleNames[name] {
+			deleteRuleARNs = append(deleteRuleARNs, ruleARN)
+		}
+	}
+
+	if err := deleteAutomationRules(ctx, conn, deleteRuleARNs); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rules (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	ruleARNs := make([]string, len(newRules))
+	var items []awstypes.UpdateAutomationRulesRequestItem
+	for i, rule := range newRules {
+		ruleARN, ok := oldRuleARNs[rule.RuleName.ValueString()]
+
+		if !ok {
+			continue
+		}
+
+		item := awstypes.UpdateAutomationRulesRequestItem{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, rule, &item)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		item.RuleArn = aws.String(ruleARN)
+		item.RuleOrder = automationRuleOrder(i)
+
+		items = append(items, item)
+		ruleARNs[i] = ruleARN
+	}
+
+	// All existing rules are updated in a single request so that their relative order changes atomically.
+	// This is done before any new rules are created so that rule orders are never duplicated.
+	if len(items) > 0 {
+		input := &securityhub.BatchUpdateAutomationRulesInput{
+			UpdateAutomationRulesRequestItems: items,
+		}
+
+		output, err := conn.BatchUpdateAutomationRules(ctx, input)
+
+		if err == nil {
+			err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Hub Automation Rules (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	for i, rule := range newRules {
+		if ruleARNs[i] != "" {
+			continue
+		}
+
+		ruleARNs[i], diags = createAutomationRule(ctx, conn, rule, i)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(new.refreshRules(ctx, conn, ruleARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *automationRulesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ruleARNs := tfslices.ApplyToAll(rules, func(rule *automationRuleModel) string {
+		return rule.RuleARN.ValueString()
+	})
+
+	if err := deleteAutomationRules(ctx, conn, ruleARNs); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *automationRulesResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Rules.IsUnknown() || state.Rules.IsNull() {
+		return
+	}
+
+	planRules, diags := plan.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	stateRules, diags := state.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules may move within the list, so carry each rule's ARN forward by name rather than by index.
+	ruleARNs := make(map[string]types.String)
+	for _, rule := range stateRules {
+		ruleARNs[rule.RuleName.ValueString()] = rule.RuleARN
+	}
+
+	for _, rule := range planRules {
+		if v, ok := ruleARNs[rule.RuleName.ValueString()]; ok && !rule.RuleName.IsUnknown() {
+			rule.RuleARN = v
+		} else {
+			rule.RuleARN = types.StringUnknown()
+		}
+	}
+
+	plan.Rules, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, planRules)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+// automationRuleOrder returns the rule order for the rule at the specified list index.
+// Rule order starts at 1 and lower values are processed first.
+func automationRuleOrder(i int) *int32 {
+	return aws.Int32(int32(i + 1))
+}
+
+func createAutomationRule(ctx context.Context, conn *securityhub.Client, rule *automationRuleModel, i int) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &securityhub.CreateAutomationRuleInput{}
+	diags.Append(fwflex.Expand(ctx, rule, input)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	input.RuleOrder = automationRuleOrder(i)
+
+	output, err := conn.CreateAutomationRule(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("creating Security Hub Automation Rule (%s)", aws.ToString(input.RuleName)), err.Error())
+
+		return "", diags
+	}
+
+	return aws.ToString(output.RuleArn), diags
+}
+
+func deleteAutomationRules(ctx context.Context, conn *securityhub.Client, ruleARNs []string) error {
+	for _, chunk := range tfslices.Chunks(ruleARNs, automationRulesMaxItems) {
+		input := &securityhub.BatchDeleteAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		}
+
+		output, err := conn.BatchDeleteAutomationRules(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err == nil {
+			err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findAllAutomationRules(ctx context.Context, conn *securityhub.Client) ([]awstypes.AutomationRulesConfig, error) {
+	ruleARNs, err := findAutomationRuleARNs(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := findAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(output, func(a, b awstypes.AutomationRulesConfig) int {
+		return cmp.Compare(aws.ToInt32(a.RuleOrder), aws.ToInt32(b.RuleOrder))
+	})
+
+	return output, nil
+}
+
+func findAutomationRuleARNs(ctx context.Context, conn *securityhub.Client, input *securityhub.ListAutomationRulesInput) ([]string, error) {
+	var output []string
+
+	for {
+		page, err := conn.ListAutomationRules(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.AutomationRulesMetadata {
+			output = append(output, aws.ToString(v.RuleArn))
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// findAutomationRulesByARNs returns the specified automation rules in the order requested.
+func findAutomationRulesByARNs(ctx context.Context, conn *securityhub.Client, ruleARNs []string) ([]awstypes.AutomationRulesConfig, error) {
+	rules := make(map[string]awstypes.AutomationRulesConfig)
+
+	for _, chunk := range tfslices.Chunks(ruleARNs, automationRulesMaxItems) {
+		input := &securityhub.BatchGetAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		}
+
+		output, err := findAutomationRules(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output {
+			rules[aws.ToString(v.RuleArn)] = v
+		}
+	}
+
+	var output []awstypes.AutomationRulesConfig
+	for _, ruleARN := range ruleARNs {
+		if v, ok := rules[ruleARN]; ok {
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func unprocessedAutomationRuleError(apiObject awstypes.UnprocessedAutomationRule) error {
+	return errors.New(aws.ToString(apiObject.ErrorMessage))
+}
+
+func unprocessedAutomationRulesError(apiObjects []awstypes.UnprocessedAutomationRule) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %w", aws.ToString(apiObject.RuleArn), unprocessedAutomationRuleError(apiObject)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type automationRulesResourceModel struct {
+	ID    types.String                                         `tfsdk:"id"`
+	Rules fwtypes.ListNestedObjectValueOf[automationRuleModel] `tfsdk:"rule"`
+}
+
+// refreshRules sets the rules from the specified automation rules, in order.
+func (data *automationRulesResourceModel) refreshRules(ctx context.Context, conn *securityhub.Client, ruleARNs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	automationRules, err := findAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Security Hub Automation Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	diags.Append(data.flattenRules(ctx, automationRules)...)
+
+	return diags
+}
+
+func (data *automationRulesResourceModel) flattenRules(ctx context.Context, apiObjects []awstypes.AutomationRulesConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rules := make([]*automationRuleModel, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		var rule automationRuleModel
+		diags.Append(fwflex.Flatten(ctx, apiObject, &rule)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	data.Rules, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, rules)
+
+	return diags
+}
+
+type automationRuleModel struct {
+	Actions     fwtypes.SetNestedObjectValueOf[automationRulesActionModel]          `tfsdk:"actions"`
+	Criteria    fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
+	Description types.String                                                        `tfsdk:"description"`
+	IsTerminal  types.Bool                                                          `tfsdk:"is_terminal"`
+	RuleARN     types.String                                                        `tfsdk:"arn"`
+	RuleName    types.String                                                        `tfsdk:"rule_name"`
+	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatus]                             `tfsdk:"rule_status"`
+}
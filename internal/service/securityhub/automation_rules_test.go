@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRules []types.AutomationRulesConfig
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName, &automationRules),
+					testAccCheckAutomationRulesOrder(&automationRules, rName1, rName2),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.is_terminal", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_status", string(types.RuleStatusEnabled)),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.1.arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_name", rName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRules []types.AutomationRulesConfig
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName, &automationRules),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRules, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_existingRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationRulesConfig_existingRule(rName1, rName2),
+				ExpectError: regexache.MustCompile(`1 automation rules already exist`),
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_reorder(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRules []types.AutomationRulesConfig
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName, &automationRules),
+					testAccCheckAutomationRulesOrder(&automationRules, rName1, rName2),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_basic(rName3, rName2, rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName, &automationRules),
+					testAccCheckAutomationRulesOrder(&automationRules, rName3, rName2, rName1),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_basic(rName1, rName3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName, &automationRules),
+					testAccCheckAutomationRulesOrder(&automationRules, rName1, rName3),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRulesExists(ctx context.Context, n string, v *[]types.AutomationRulesConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["rule.#"])
+		if err != nil {
+			return err
+		}
+
+		var ruleARNs []string
+		for i := 0; i < count; i++ {
+			ruleARNs = append(ruleARNs, rs.Primary.Attributes[fmt.Sprintf("rule.%d.arn", i)])
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		output, err := tfsecurityhub.FindAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) != count {
+			return fmt.Errorf("Security Hub Automation Rules %s: expected %d rules, got %d", rs.Primary.ID, count, len(output))
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRulesOrder(v *[]types.AutomationRulesConfig, ruleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(*v) != len(ruleNames) {
+			return fmt.Errorf("expected %d rules, got %d", len(ruleNames), len(*v))
+		}
+
+		for i, rule := range *v {
+			if got, want := aws.ToString(rule.RuleName), ruleNames[i]; got != want {
+				return fmt.Errorf("rule %d: expected rule_name %q, got %q", i, want, got)
+			}
+
+			if got, want := aws.ToInt32(rule.RuleOrder), int32(i+1); got != want {
+				return fmt.Errorf("rule %q: expected rule order %d, got %d", aws.ToString(rule.RuleName), want, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rules" {
+				continue
+			}
+
+			var ruleARNs []string
+			for k, v := range rs.Primary.Attributes {
+				if strings.HasPrefix(k, "rule.") && strings.HasSuffix(k, ".arn") {
+					ruleARNs = append(ruleARNs, v)
+				}
+			}
+
+			output, err := tfsecurityhub.FindAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("Security Hub Automation Rules %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomationRulesConfig_basic(ruleNames ...string) string {
+	var rules strings.Builder
+
+	for _, ruleName := range ruleNames {
+		fmt.Fprintf(&rules, `
+  rule {
+    description = "test description"
+    rule_name   = %[1]q
+
+    actions {
+      finding_fields_update {
+        user_defined_fields = {
+          key = "value"
+        }
+      }
+      type = "FINDING_FIELDS_UPDATE"
+    }
+
+    criteria {
+      aws_account_id {
+        comparison = "EQUALS"
+        value      = "1234567890"
+      }
+    }
+  }
+`, ruleName)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rules" "test" {
+%[1]s
+  depends_on = [aws_securityhub_account.test]
+}
+`, rules.String())
+}
+
+func testAccAutomationRulesConfig_existingRule(existingRuleName, ruleName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      user_defined_fields = {
+        key = "value"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_automation_rules" "test" {
+  rule {
+    description = "test description"
+    rule_name   = %[2]q
+
+    actions {
+      finding_fields_update {
+        user_defined_fields = {
+          key = "value"
+        }
+      }
+      type = "FINDING_FIELDS_UPDATE"
+    }
+
+    criteria {
+      aws_account_id {
+        comparison = "EQUALS"
+        value      = "1234567890"
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_automation_rule.test]
+}
+`, existingRuleName, ruleName)
+}
@@ -40,9 +40,22 @@ func resourceConfigurationPolicyAssociation() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Second),
 			Update: schema.DefaultTimeout(90 * time.Second),
+			Delete: schema.DefaultTimeout(90 * time.Second),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"association_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"policy_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -109,6 +122,9 @@ func resourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.R
 		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
 	}
 
+	d.Set("association_status", output.AssociationStatus)
+	d.Set("association_status_message", output.AssociationStatusMessage)
+	d.Set("association_type", output.AssociationType)
 	d.Set("policy_id", output.ConfigurationPolicyId)
 	d.Set("target_id", output.TargetId)
 
@@ -133,6 +149,11 @@ func resourceConfigurationPolicyAssociationDelete(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy Disassociation (%s): %s", d.Id(), err)
 	}
 
+	// After disassociation the target reverts to its inherited configuration, if any.
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Disassociation (%s) success: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_securityhub_configuration_policy_association", name="Configuration Policy Association")
+func dataSourceConfigurationPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationPolicyAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"association_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexache.MustCompile(`^(r-[a-z0-9]{4,32})$|^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32})$|^([0-9]{12})$`),
+					"Target ID must be a valid root, organizational unit or account id.",
+				),
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	targetID := d.Get("target_id").(string)
+	output, err := findConfigurationPolicyAssociationByID(ctx, conn, targetID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Association (%s): %s", targetID, err)
+	}
+
+	d.SetId(targetID)
+	d.Set("association_status", output.AssociationStatus)
+	d.Set("association_status_message", output.AssociationStatusMessage)
+	d.Set("association_type", output.AssociationType)
+	d.Set("policy_id", output.ConfigurationPolicyId)
+	d.Set("target_id", output.TargetId)
+	d.Set("target_type", output.TargetType)
+	if output.UpdatedAt != nil {
+		d.Set("updated_at", aws.ToTime(output.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	return diags
+}
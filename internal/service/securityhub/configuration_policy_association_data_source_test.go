@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicyAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providers := make(map[string]*schema.Provider)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_securityhub_configuration_policy_association.test"
+	resourceName := "aws_securityhub_configuration_policy_association.test"
+	ouTarget := "aws_organizations_organizational_unit.test.id"
+	policy1 := "aws_securityhub_configuration_policy.test_1.id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Run a simple configuration to initialize the alternate providers
+				Config: testAccOrganizationConfigurationConfig_centralConfigurationInit,
+			},
+			{
+				PreConfig: func() {
+					// Can only run check here because the provider is not available until the previous step.
+					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
+				},
+				Config: testAccConfigurationPolicyAssociationDataSourceConfig_basic(rName, ouTarget, policy1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "association_status", string(types.ConfigurationPolicyAssociationStatusSuccess)),
+					resource.TestCheckResourceAttr(dataSourceName, "association_type", string(types.AssociationTypeApplied)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", resourceName, "target_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_type", string(types.TargetTypeOrganizationalUnit)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicyAssociationDataSourceConfig_basic(rName, targetID, policyID string) string {
+	return acctest.ConfigCompose(testAccConfigurationPolicyAssociationConfig_basic(rName, targetID, policyID), `
+data "aws_securityhub_configuration_policy_association" "test" {
+  target_id = aws_securityhub_configuration_policy_association.test.target_id
+}
+`)
+}
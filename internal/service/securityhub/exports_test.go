@@ -8,6 +8,7 @@ var (
 	ResourceAccount                        = resourceAccount
 	ResourceActionTarget                   = resourceActionTarget
 	ResourceAutomationRule                 = newAutomationRuleResource
+	ResourceAutomationRules                = newAutomationRulesResource
 	ResourceConfigurationPolicy            = resourceConfigurationPolicy
 	ResourceConfigurationPolicyAssociation = resourceConfigurationPolicyAssociation
 	ResourceFindingAggregator              = resourceFindingAggregator
@@ -24,6 +25,7 @@ var (
 	FindActionTargetByARN                         = findActionTargetByARN
 	FindAdminAccountByID                          = findAdminAccountByID
 	FindAutomationRuleByARN                       = findAutomationRuleByARN
+	FindAutomationRulesByARNs                     = findAutomationRulesByARNs
 	FindConfigurationPolicyAssociationByID        = findConfigurationPolicyAssociationByID
 	FindConfigurationPolicyByID                   = findConfigurationPolicyByID
 	FindFindingAggregatorByARN                    = findFindingAggregatorByARN
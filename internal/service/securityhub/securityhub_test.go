@@ -31,6 +31,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"mapFilters":         testAccAutomationRule_mapFilters,
 			"tags":               testAccAutomationRule_tags,
 		},
+		"AutomationRules": {
+			acctest.CtBasic:      testAccAutomationRules_basic,
+			acctest.CtDisappears: testAccAutomationRules_disappears,
+			"existingRules":      testAccAutomationRules_existingRules,
+			"reorder":            testAccAutomationRules_reorder,
+		},
 		"ActionTarget": {
 			acctest.CtBasic:      testAccActionTarget_basic,
 			acctest.CtDisappears: testAccActionTarget_disappears,
@@ -47,6 +53,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationPolicyAssociation_basic,
 			acctest.CtDisappears: testAccConfigurationPolicyAssociation_disappears,
 		},
+		"ConfigurationPolicyAssociationDataSource": {
+			acctest.CtBasic: testAccConfigurationPolicyAssociationDataSource_basic,
+		},
 		"FindingAggregator": {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
@@ -170,3 +170,24 @@ func findProductByID(ctx context.Context, conn *servicecatalog.ServiceCatalog, p
 
 	return out, nil
 }
+
+func findProvisioningParameters(ctx context.Context, conn *servicecatalog.ServiceCatalog, input *servicecatalog.DescribeProvisioningParametersInput) (*servicecatalog.DescribeProvisioningParametersOutput, error) {
+	output, err := conn.DescribeProvisioningParametersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -8,11 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -264,6 +266,7 @@ func ResourceProvisionedProduct() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			refreshOutputsDiff,
+			validateProvisioningParametersDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -279,6 +282,109 @@ func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// validateProvisioningParametersDiff checks the configured provisioning parameters against those
+// accepted by the provisioning artifact so that unknown keys or disallowed values fail at plan time.
+func validateProvisioningParametersDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("provisioning_parameters", "product_id", "product_name", "provisioning_artifact_id", "provisioning_artifact_name", "path_id", "path_name") {
+		return nil
+	}
+
+	tfList := diff.Get("provisioning_parameters").([]interface{})
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	for _, key := range []string{"provisioning_parameters", "product_id", "product_name", "provisioning_artifact_id", "provisioning_artifact_name", "path_id", "path_name"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	input := &servicecatalog.DescribeProvisioningParametersInput{
+		AcceptLanguage: aws.String(diff.Get("accept_language").(string)),
+	}
+
+	// On create product_id and provisioning_artifact_id are only set if configured.
+	// On update prefer the configured names, as the IDs are also computed.
+	if v, ok := diff.GetOk("product_name"); ok {
+		input.ProductName = aws.String(v.(string))
+	} else if v, ok := diff.GetOk("product_id"); ok {
+		input.ProductId = aws.String(v.(string))
+	} else {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("provisioning_artifact_name"); ok {
+		input.ProvisioningArtifactName = aws.String(v.(string))
+	} else if v, ok := diff.GetOk("provisioning_artifact_id"); ok {
+		input.ProvisioningArtifactId = aws.String(v.(string))
+	} else {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("path_name"); ok {
+		input.PathName = aws.String(v.(string))
+	} else if v, ok := diff.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+	output, err := findProvisioningParameters(ctx, conn, input)
+
+	if err != nil {
+		// The check is best-effort: the product may not be launchable yet, e.g. its portfolio association
+		// is being created in the same apply, or the caller may lack permission to describe it.
+		tflog.Warn(ctx, "Unable to validate Service Catalog Provisioned Product provisioning parameters, skipping", map[string]any{
+			"product_id":                 aws.StringValue(input.ProductId),
+			"product_name":               aws.StringValue(input.ProductName),
+			"provisioning_artifact_id":   aws.StringValue(input.ProvisioningArtifactId),
+			"provisioning_artifact_name": aws.StringValue(input.ProvisioningArtifactName),
+			"error":                      err.Error(),
+		})
+		return nil
+	}
+
+	parameters := make(map[string]*servicecatalog.ProvisioningArtifactParameter)
+	for _, v := range output.ProvisioningArtifactParameters {
+		if v == nil {
+			continue
+		}
+
+		parameters[aws.StringValue(v.ParameterKey)] = v
+	}
+
+	var errs []error
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := tfMap[names.AttrKey].(string)
+		parameter, ok := parameters[key]
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("provisioning_parameters.%d: parameter %q is not defined by the provisioning artifact", i, key))
+			continue
+		}
+
+		if tfMap["use_previous_value"].(bool) || parameter.ParameterConstraints == nil || len(parameter.ParameterConstraints.AllowedValues) == 0 {
+			continue
+		}
+
+		value := tfMap[names.AttrValue].(string)
+		allowedValues := aws.StringValueSlice(parameter.ParameterConstraints.AllowedValues)
+
+		if !slices.Contains(allowedValues, value) {
+			errs = append(errs, fmt.Errorf("provisioning_parameters.%d: value %q for parameter %q is not one of the allowed values %q", i, value, key, allowedValues))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
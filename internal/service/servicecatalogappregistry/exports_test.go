@@ -5,6 +5,10 @@ package servicecatalogappregistry
 
 // Exports for use in tests only.
 var (
-	FindApplicationByID = findApplicationByID
-	ResourceApplication = newResourceApplication
+	FindApplicationByID                       = findApplicationByID
+	FindAttributeGroupAssociationByTwoPartKey = findAttributeGroupAssociationByTwoPartKey
+	FindAttributeGroupByID                    = findAttributeGroupByID
+	ResourceApplication                       = newResourceApplication
+	ResourceAttributeGroup                    = newResourceAttributeGroup
+	ResourceAttributeGroupAssociation         = newResourceAttributeGroupAssociation
 )
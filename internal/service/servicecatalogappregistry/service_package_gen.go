@@ -30,6 +30,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceApplication,
 			Name:    "Application",
 		},
+		{
+			Factory: newResourceAttributeGroup,
+			Name:    "Attribute Group",
+		},
+		{
+			Factory: newResourceAttributeGroupAssociation,
+			Name:    "Attribute Group Association",
+		},
 	}
 }
 
@@ -10,10 +10,12 @@ var (
 	ResourceApplicationLayerAutomaticResponse = newApplicationLayerAutomaticResponseResource
 	ResourceProactiveEngagement               = newProactiveEngagementResource
 	ResourceProtection                        = resourceProtection
+	ResourceProtectionHealthCheckAssociation  = resourceProtectionHealthCheckAssociation
 
 	FindApplicationLayerAutomaticResponseByResourceARN = findApplicationLayerAutomaticResponseByResourceARN
 	FindDRTLogBucketAssociation                        = findDRTLogBucketAssociation
 	FindDRTRoleARNAssociation                          = findDRTRoleARNAssociation
 	FindEmergencyContactSettings                       = findEmergencyContactSettings
 	FindProtectionByID                                 = findProtectionByID
+	FindProtectionHealthCheckAssociationByTwoPartKey   = findProtectionHealthCheckAssociationByTwoPartKey
 )
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -69,12 +70,13 @@ func (r *proactiveEngagementResource) Schema(ctx context.Context, request resour
 							Required: true,
 							Validators: []validator.String{
 								stringvalidator.LengthBetween(1, 150),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^\S+@\S+$`), "must be a valid email address"),
 							},
 						},
 						"phone_number": schema.StringAttribute{
 							Optional: true,
 							Validators: []validator.String{
-								stringvalidator.RegexMatches(regexache.MustCompile(`^\+[1-9]\d{1,14}$`), ""),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^\+[1-9]\d{1,14}$`), "must be in E.164 format"),
 							},
 						},
 					},
@@ -84,6 +86,42 @@ func (r *proactiveEngagementResource) Schema(ctx context.Context, request resour
 	}
 }
 
+func (r *proactiveEngagementResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A phone number is only needed to enable proactive engagement.
+	if data.Enabled.IsUnknown() || !data.Enabled.ValueBool() {
+		return
+	}
+
+	if data.EmergencyContactList.IsNull() || data.EmergencyContactList.IsUnknown() {
+		return
+	}
+
+	emergencyContacts, diags := data.EmergencyContactList.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The emergency contact list must include at least one phone number.
+	for _, emergencyContact := range emergencyContacts {
+		if !emergencyContact.PhoneNumber.IsNull() {
+			return
+		}
+	}
+
+	response.Diagnostics.AddAttributeError(
+		path.Root("emergency_contact"),
+		"Missing Attribute Configuration",
+		"at least one emergency_contact must specify phone_number",
+	)
+}
+
 func (r *proactiveEngagementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data proactiveEngagementResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
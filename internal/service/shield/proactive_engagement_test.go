@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/shield/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -83,6 +84,55 @@ func testAccProactiveEngagement_disabled(t *testing.T) {
 	})
 }
 
+func testAccProactiveEngagement_noPhoneNumber(t *testing.T) {
+	ctx := acctest.Context(t)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckProactiveEngagement(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProactiveEngagementConfig_noPhoneNumber(address, true),
+				ExpectError: regexache.MustCompile(`at least one emergency_contact must specify phone_number`),
+			},
+		},
+	})
+}
+
+func testAccProactiveEngagement_disabledNoPhoneNumber(t *testing.T) {
+	ctx := acctest.Context(t)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+	var proactiveengagementassociation []types.EmergencyContact
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckProactiveEngagement(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_noPhoneNumber(address, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementAssociationExists(ctx, resourceName, &proactiveengagementassociation),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", address),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccProactiveEngagement_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	domain := acctest.RandomDomainName()
@@ -224,3 +274,15 @@ resource "aws_shield_proactive_engagement" "test" {
 
 `, rName, email1, email2, enabled)
 }
+
+func testAccProactiveEngagementConfig_noPhoneNumber(email string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[2]t
+
+  emergency_contact {
+    email_address = %[1]q
+  }
+}
+`, email, enabled)
+}
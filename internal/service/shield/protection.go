@@ -6,6 +6,7 @@ package shield
 import (
 	"context"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
@@ -40,6 +41,11 @@ func resourceProtection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"health_check_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -98,6 +104,10 @@ func resourceProtectionRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set(names.AttrARN, protection.ProtectionArn)
+	// Sort so that the order of associated health checks is stable between refreshes.
+	healthCheckIDs := slices.Clone(protection.HealthCheckIds)
+	slices.Sort(healthCheckIDs)
+	d.Set("health_check_ids", healthCheckIDs)
 	d.Set(names.AttrName, protection.Name)
 	d.Set(names.AttrResourceARN, protection.ResourceArn)
 
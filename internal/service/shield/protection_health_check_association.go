@@ -5,36 +5,44 @@ package shield
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// @SDKResource("aws_shield_protection_health_check_association")
-func ResourceProtectionHealthCheckAssociation() *schema.Resource {
+// @SDKResource("aws_shield_protection_health_check_association", name="Protection Health Check Association")
+func resourceProtectionHealthCheckAssociation() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: ResourceProtectionHealthCheckAssociationCreate,
-		ReadWithoutTimeout:   ResourceProtectionHealthCheckAssociationRead,
-		DeleteWithoutTimeout: ResourceProtectionHealthCheckAssociationDelete,
+		CreateWithoutTimeout: resourceProtectionHealthCheckAssociationCreate,
+		ReadWithoutTimeout:   resourceProtectionHealthCheckAssociationRead,
+		DeleteWithoutTimeout: resourceProtectionHealthCheckAssociationDelete,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
-			"shield_protection_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
 			"health_check_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shield_protection_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
@@ -43,45 +51,42 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 	}
 }
 
-func ResourceProtectionHealthCheckAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceProtectionHealthCheckAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
 
-	protectionId := d.Get("shield_protection_id").(string)
-	healthCheckArn := d.Get("health_check_arn").(string)
-	id := ProtectionHealthCheckAssociationCreateResourceID(protectionId, healthCheckArn)
-
+	protectionID := d.Get("shield_protection_id").(string)
+	healthCheckARN := d.Get("health_check_arn").(string)
+	id := ProtectionHealthCheckAssociationCreateResourceID(protectionID, healthCheckARN)
 	input := &shield.AssociateHealthCheckInput{
-		ProtectionId:   aws.String(protectionId),
-		HealthCheckArn: aws.String(healthCheckArn),
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
 	}
 
 	_, err := conn.AssociateHealthCheck(ctx, input)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "associating Route53 Health Check (%s) with Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
+		return sdkdiag.AppendErrorf(diags, "creating Shield Protection Health Check Association (%s): %s", id, err)
 	}
+
 	d.SetId(id)
-	return append(diags, ResourceProtectionHealthCheckAssociationRead(ctx, d, meta)...)
+
+	return append(diags, resourceProtectionHealthCheckAssociationRead(ctx, d, meta)...)
 }
 
-func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
 
-	protectionId, healthCheckArn, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
-
+	protectionID, healthCheckARN, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "parsing Shield Protection and Route53 Health Check Association ID: %s", err)
-	}
-
-	input := &shield.DescribeProtectionInput{
-		ProtectionId: aws.String(protectionId),
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	resp, err := conn.DescribeProtection(ctx, input)
+	protection, err := findProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, protectionID, healthCheckARN)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		log.Printf("[WARN] Shield Protection itself (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
@@ -90,46 +95,69 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "reading Shield Protection Health Check Association (%s): %s", d.Id(), err)
 	}
 
-	isHealthCheck := stringInSlice(strings.Split(healthCheckArn, "/")[1], resp.Protection.HealthCheckIds)
-	if !isHealthCheck {
-		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-	}
-
-	d.Set("health_check_arn", healthCheckArn)
-	d.Set("shield_protection_id", resp.Protection.Id)
+	d.Set("health_check_arn", healthCheckARN)
+	d.Set("shield_protection_id", protection.Id)
 
 	return diags
 }
 
-func ResourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
 
-	protectionId, healthCheckId, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
+	protectionID, healthCheckARN, err := ProtectionHealthCheckAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Shield Protection Health Check Association: %s", d.Id())
+	_, err = conn.DisassociateHealthCheck(ctx, &shield.DisassociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "parsing Shield Protection and Route53 Health Check Association ID: %s", err)
+		return sdkdiag.AppendErrorf(diags, "deleting Shield Protection Health Check Association (%s): %s", d.Id(), err)
 	}
 
-	input := &shield.DisassociateHealthCheckInput{
-		ProtectionId:   aws.String(protectionId),
-		HealthCheckArn: aws.String(healthCheckId),
+	return diags
+}
+
+func findProtectionHealthCheckAssociationByTwoPartKey(ctx context.Context, conn *shield.Client, protectionID, healthCheckARN string) (*awstypes.Protection, error) {
+	healthCheckID, err := healthCheckIDFromARN(healthCheckARN)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = conn.DisassociateHealthCheck(ctx, input)
+	output, err := findProtectionByID(ctx, conn, protectionID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
+		return nil, err
 	}
-	return diags
+
+	if !slices.Contains(output.HealthCheckIds, healthCheckID) {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
 }
 
-func stringInSlice(expected string, list []string) bool {
-	for _, item := range list {
-		if item == expected {
-			return true
-		}
+// healthCheckIDFromARN returns the Route 53 health check ID from a health check ARN,
+// e.g. arn:aws:route53:::healthcheck/3742b175-edb9-46bc-9359-f53e3b794b1b.
+func healthCheckIDFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", err
 	}
-	return false
+
+	const prefix = "healthcheck/"
+	if !strings.HasPrefix(v.Resource, prefix) || v.Resource == prefix {
+		return "", fmt.Errorf("unexpected format for Route 53 Health Check ARN (%s)", s)
+	}
+
+	return strings.TrimPrefix(v.Resource, prefix), nil
 }
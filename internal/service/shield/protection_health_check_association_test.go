@@ -8,16 +8,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/shield"
-	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -40,6 +37,15 @@ func TestAccShieldProtectionHealthCheckAssociation_basic(t *testing.T) {
 				Config: testAccProtectionHealthCheckAssociationConfig_protectionaHealthCheckAssociation(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProtectionHealthCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "health_check_arn", "aws_route53_health_check.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "shield_protection_id", "aws_shield_protection.test", names.AttrID),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_shield_protection.test", "health_check_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("aws_shield_protection.test", "health_check_ids.0", "aws_route53_health_check.test", names.AttrID),
 				),
 			},
 			{
@@ -87,19 +93,9 @@ func testAccCheckProtectionHealthCheckAssociationDestroy(ctx context.Context) re
 				continue
 			}
 
-			protectionId, _, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
+			_, err := tfshield.FindProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["shield_protection_id"], rs.Primary.Attributes["health_check_arn"])
 
-			if err != nil {
-				return err
-			}
-
-			input := &shield.DescribeProtectionInput{
-				ProtectionId: aws.String(protectionId),
-			}
-
-			resp, err := conn.DescribeProtection(ctx, input)
-
-			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -107,53 +103,25 @@ func testAccCheckProtectionHealthCheckAssociationDestroy(ctx context.Context) re
 				return err
 			}
 
-			if resp != nil && resp.Protection != nil && len(resp.Protection.HealthCheckIds) == 0 {
-				return fmt.Errorf("The Shield protection HealthCheck with IDs %v still exists", resp.Protection.HealthCheckIds)
-			}
+			return fmt.Errorf("Shield Protection Health Check Association %s still exists", rs.Primary.ID)
 		}
 
 		return nil
 	}
 }
 
-func testAccCheckProtectionHealthCheckAssociationExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+func testAccCheckProtectionHealthCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[resourceName]
+		rs, ok := s.RootModule().Resources[n]
 		if !ok {
-			return fmt.Errorf("Not found: %s", resourceName)
-		}
-
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No Shield Protection and Route53 Health Check Association ID is set")
-		}
-
-		protectionId, _, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
-
-		if err != nil {
-			return err
+			return fmt.Errorf("Not found: %s", n)
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldClient(ctx)
 
-		input := &shield.DescribeProtectionInput{
-			ProtectionId: aws.String(protectionId),
-		}
-
-		resp, err := conn.DescribeProtection(ctx, input)
+		_, err := tfshield.FindProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["shield_protection_id"], rs.Primary.Attributes["health_check_arn"])
 
-		if err != nil {
-			return err
-		}
-
-		if resp == nil || resp.Protection == nil {
-			return fmt.Errorf("The Shield protection does not exist")
-		}
-
-		if resp.Protection.HealthCheckIds == nil || len(resp.Protection.HealthCheckIds) != 1 {
-			return fmt.Errorf("The Shield protection HealthCheck does not exist")
-		}
-
-		return nil
+		return err
 	}
 }
 
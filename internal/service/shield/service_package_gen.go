@@ -60,8 +60,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceProtectionHealthCheckAssociation,
+			Factory:  resourceProtectionHealthCheckAssociation,
 			TypeName: "aws_shield_protection_health_check_association",
+			Name:     "Protection Health Check Association",
 		},
 	}
 }
@@ -23,9 +23,11 @@ func TestAccShield_serial(t *testing.T) {
 			acctest.CtDisappears: testAccDRTAccessRoleARNAssociation_disappears,
 		},
 		"ProactiveEngagement": {
-			acctest.CtBasic:      testAccProactiveEngagement_basic,
-			"disabled":           testAccProactiveEngagement_disabled,
-			acctest.CtDisappears: testAccProactiveEngagement_disappears,
+			acctest.CtBasic:         testAccProactiveEngagement_basic,
+			"disabled":              testAccProactiveEngagement_disabled,
+			"disabledNoPhoneNumber": testAccProactiveEngagement_disabledNoPhoneNumber,
+			"noPhoneNumber":         testAccProactiveEngagement_noPhoneNumber,
+			acctest.CtDisappears:    testAccProactiveEngagement_disappears,
 		},
 	}
 
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Grant")
+func newResourceApplicationGrant(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationGrant{}, nil
+}
+
+const (
+	ResNameApplicationGrant = "Application Grant"
+
+	applicationGrantIDPartCount = 2
+)
+
+type resourceApplicationGrant struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationGrant) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_ssoadmin_application_grant"
+}
+
+func (r *resourceApplicationGrant) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grant_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.GrantType](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"redirect_uris": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"authorized_token_issuer": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authorizedTokenIssuerData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorized_audiences": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"trusted_token_issuer_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationGrant) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan resourceApplicationGrantData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.ApplicationARN.ValueString(),
+		plan.GrantType.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, applicationGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationGrant, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putApplicationGrant(ctx, conn, &plan, &resp.Diagnostics); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationGrant, id, err),
+			err.Error(),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplicationGrant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findApplicationGrantByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// ApplicationARN and GrantType are not returned in the finder output. To allow import to set
+	// all attributes correctly, parse the ID for these values instead.
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), applicationGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationARN = fwtypes.ARNValue(parts[0])
+	state.GrantType = types.StringValue(parts[1])
+	state.RedirectURIs = types.ListNull(types.StringType)
+	state.AuthorizedTokenIssuers = fwtypes.NewListNestedObjectValueOfNull[authorizedTokenIssuerData](ctx)
+
+	switch v := out.Grant.(type) {
+	case *awstypes.GrantMemberAuthorizationCode:
+		state.RedirectURIs = flex.FlattenFrameworkStringValueList(ctx, v.Value.RedirectUris)
+	case *awstypes.GrantMemberJwtBearer:
+		issuers, d := flattenAuthorizedTokenIssuers(ctx, v.Value.AuthorizedTokenIssuers)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AuthorizedTokenIssuers = issuers
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplicationGrant) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var plan, state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RedirectURIs.Equal(state.RedirectURIs) || !plan.AuthorizedTokenIssuers.Equal(state.AuthorizedTokenIssuers) {
+		if err := putApplicationGrant(ctx, conn, &plan, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationGrant, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceApplicationGrant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceApplicationGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &ssoadmin.DeleteApplicationGrantInput{
+		ApplicationArn: aws.String(state.ApplicationARN.ValueString()),
+		GrantType:      awstypes.GrantType(state.GrantType.ValueString()),
+	}
+
+	_, err := conn.DeleteApplicationGrant(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceApplicationGrant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func putApplicationGrant(ctx context.Context, conn *ssoadmin.Client, data *resourceApplicationGrantData, diags *diag.Diagnostics) error {
+	in := &ssoadmin.PutApplicationGrantInput{
+		ApplicationArn: aws.String(data.ApplicationARN.ValueString()),
+		GrantType:      awstypes.GrantType(data.GrantType.ValueString()),
+	}
+
+	switch grantType := awstypes.GrantType(data.GrantType.ValueString()); grantType {
+	case awstypes.GrantTypeAuthorizationCode:
+		in.Grant = &awstypes.GrantMemberAuthorizationCode{
+			Value: awstypes.AuthorizationCodeGrant{
+				RedirectUris: flex.ExpandFrameworkStringValueList(ctx, data.RedirectURIs),
+			},
+		}
+	case awstypes.GrantTypeJwtBearer:
+		issuers, d := expandAuthorizedTokenIssuers(ctx, data.AuthorizedTokenIssuers)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil
+		}
+		in.Grant = &awstypes.GrantMemberJwtBearer{
+			Value: awstypes.JwtBearerGrant{
+				AuthorizedTokenIssuers: issuers,
+			},
+		}
+	case awstypes.GrantTypeRefreshToken:
+		in.Grant = &awstypes.GrantMemberRefreshToken{}
+	case awstypes.GrantTypeTokenExchange:
+		in.Grant = &awstypes.GrantMemberTokenExchange{}
+	default:
+		return fmt.Errorf("unsupported grant type: %s", grantType)
+	}
+
+	_, err := conn.PutApplicationGrant(ctx, in)
+
+	return err
+}
+
+func findApplicationGrantByID(ctx context.Context, conn *ssoadmin.Client, id string) (*ssoadmin.GetApplicationGrantOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, applicationGrantIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &ssoadmin.GetApplicationGrantInput{
+		ApplicationArn: aws.String(parts[0]),
+		GrantType:      awstypes.GrantType(parts[1]),
+	}
+
+	out, err := conn.GetApplicationGrant(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.Grant == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAuthorizedTokenIssuers(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[authorizedTokenIssuerData]) ([]awstypes.AuthorizedTokenIssuer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObjects, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.AuthorizedTokenIssuer
+
+	for _, tfObject := range tfObjects {
+		apiObjects = append(apiObjects, awstypes.AuthorizedTokenIssuer{
+			AuthorizedAudiences:   flex.ExpandFrameworkStringValueList(ctx, tfObject.AuthorizedAudiences),
+			TrustedTokenIssuerArn: aws.String(tfObject.TrustedTokenIssuerARN.ValueString()),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func flattenAuthorizedTokenIssuers(ctx context.Context, apiObjects []awstypes.AuthorizedTokenIssuer) (fwtypes.ListNestedObjectValueOf[authorizedTokenIssuerData], diag.Diagnostics) {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[authorizedTokenIssuerData](ctx), nil
+	}
+
+	var tfObjects []*authorizedTokenIssuerData
+
+	for _, apiObject := range apiObjects {
+		tfObjects = append(tfObjects, &authorizedTokenIssuerData{
+			AuthorizedAudiences:   flex.FlattenFrameworkStringValueList(ctx, apiObject.AuthorizedAudiences),
+			TrustedTokenIssuerARN: fwtypes.ARNValue(aws.ToString(apiObject.TrustedTokenIssuerArn)),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSlice(ctx, tfObjects)
+}
+
+type resourceApplicationGrantData struct {
+	ApplicationARN         fwtypes.ARN                                                `tfsdk:"application_arn"`
+	AuthorizedTokenIssuers fwtypes.ListNestedObjectValueOf[authorizedTokenIssuerData] `tfsdk:"authorized_token_issuer"`
+	GrantType              types.String                                               `tfsdk:"grant_type"`
+	ID                     types.String                                               `tfsdk:"id"`
+	RedirectURIs           types.List                                                 `tfsdk:"redirect_uris"`
+}
+
+type authorizedTokenIssuerData struct {
+	AuthorizedAudiences   types.List  `tfsdk:"authorized_audiences"`
+	TrustedTokenIssuerARN fwtypes.ARN `tfsdk:"trusted_token_issuer_arn"`
+}
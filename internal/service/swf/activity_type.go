@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_swf_activity_type", name="Activity Type")
+func resourceActivityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceActivityTypeCreate,
+		ReadWithoutTimeout:   resourceActivityTypeRead,
+		DeleteWithoutTimeout: resourceActivityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customizeTypeDiff(
+			"default_task_heartbeat_timeout",
+			"default_task_list",
+			"default_task_priority",
+			"default_task_schedule_to_close_timeout",
+			"default_task_schedule_to_start_timeout",
+			"default_task_start_to_close_timeout",
+			names.AttrDescription,
+		),
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimeout,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimeout,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+// Activity and workflow types are identified by domain, name and version.
+const typeResourceIDPartCount = 3
+
+// customizeTypeDiff rejects changes to a registered type's configuration unless its version also changes.
+// A type's configuration is fixed when it is registered, and a deprecated type keeps its domain, name and version.
+func customizeTypeDiff(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || d.HasChanges(names.AttrDomain, names.AttrName, names.AttrVersion) {
+			return nil
+		}
+
+		for _, key := range keys {
+			if d.HasChange(key) {
+				return fmt.Errorf("%s cannot be changed without also changing version", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+// typeConfigurationMismatches returns the keys whose configured value differs from the registered value.
+// Values that are not configured are ignored if the registered value is computed by SWF.
+func typeConfigurationMismatches(values map[string][2]*string, computedKeys ...string) []string {
+	var mismatches []string
+
+	for key, v := range values {
+		want, got := v[0], v[1]
+
+		if want == nil && slices.Contains(computedKeys, key) {
+			continue
+		}
+
+		if aws.ToString(want) != aws.ToString(got) {
+			mismatches = append(mismatches, key)
+		}
+	}
+
+	slices.Sort(mismatches)
+
+	return mismatches
+}
+
+func resourceActivityTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	domain, name, version := d.Get(names.AttrDomain).(string), d.Get(names.AttrName).(string), d.Get(names.AttrVersion).(string)
+	id, err := flex.FlattenResourceId([]string{domain, name, version}, typeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &types.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.RegisterActivityType(ctx, input)
+
+	// Activity types cannot be deleted, only deprecated, so re-creating a destroyed type undeprecates it.
+	if errs.IsA[*types.TypeAlreadyExistsFault](err) {
+		err = undeprecateActivityType(ctx, conn, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SWF Activity Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceActivityTypeRead(ctx, d, meta)...)
+}
+
+func resourceActivityTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), typeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	output, err := findActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCreationDate, aws.ToTime(output.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", output.Configuration.DefaultTaskHeartbeatTimeout)
+	if output.Configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", output.Configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", output.Configuration.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", output.Configuration.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", output.Configuration.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", output.Configuration.DefaultTaskStartToCloseTimeout)
+	d.Set(names.AttrDescription, output.TypeInfo.Description)
+	d.Set(names.AttrDomain, domain)
+	d.Set(names.AttrName, output.TypeInfo.ActivityType.Name)
+	d.Set(names.AttrStatus, output.TypeInfo.Status)
+	d.Set(names.AttrVersion, output.TypeInfo.ActivityType.Version)
+
+	return diags
+}
+
+func resourceActivityTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	// Activity types cannot be deleted, only deprecated.
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", d.Id())
+	_, err := conn.DeprecateActivityType(ctx, &swf.DeprecateActivityTypeInput{
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(d.Get(names.AttrName).(string)),
+			Version: aws.String(d.Get(names.AttrVersion).(string)),
+		},
+		Domain: aws.String(d.Get(names.AttrDomain).(string)),
+	})
+
+	if errs.IsA[*types.TypeDeprecatedFault](err) || errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func undeprecateActivityType(ctx context.Context, conn *swf.Client, registerInput *swf.RegisterActivityTypeInput) error {
+	activityType := &types.ActivityType{
+		Name:    registerInput.Name,
+		Version: registerInput.Version,
+	}
+	output, err := conn.DescribeActivityType(ctx, &swf.DescribeActivityTypeInput{
+		ActivityType: activityType,
+		Domain:       registerInput.Domain,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if status := output.TypeInfo.Status; status != types.RegistrationStatusDeprecated {
+		return fmt.Errorf("already exists with status %s", status)
+	}
+
+	var defaultTaskList *string
+	if v := registerInput.DefaultTaskList; v != nil {
+		defaultTaskList = v.Name
+	}
+	var registeredDefaultTaskList *string
+	if v := output.Configuration.DefaultTaskList; v != nil {
+		registeredDefaultTaskList = v.Name
+	}
+
+	if mismatches := typeConfigurationMismatches(map[string][2]*string{
+		"default_task_heartbeat_timeout":         {registerInput.DefaultTaskHeartbeatTimeout, output.Configuration.DefaultTaskHeartbeatTimeout},
+		"default_task_list":                      {defaultTaskList, registeredDefaultTaskList},
+		"default_task_priority":                  {registerInput.DefaultTaskPriority, output.Configuration.DefaultTaskPriority},
+		"default_task_schedule_to_close_timeout": {registerInput.DefaultTaskScheduleToCloseTimeout, output.Configuration.DefaultTaskScheduleToCloseTimeout},
+		"default_task_schedule_to_start_timeout": {registerInput.DefaultTaskScheduleToStartTimeout, output.Configuration.DefaultTaskScheduleToStartTimeout},
+		"default_task_start_to_close_timeout":    {registerInput.DefaultTaskStartToCloseTimeout, output.Configuration.DefaultTaskStartToCloseTimeout},
+		names.AttrDescription:                    {registerInput.Description, output.TypeInfo.Description},
+	}, "default_task_heartbeat_timeout", "default_task_priority", "default_task_schedule_to_close_timeout", "default_task_schedule_to_start_timeout", "default_task_start_to_close_timeout"); len(mismatches) > 0 {
+		return fmt.Errorf("a deprecated type with the same version is registered with a different %s; use a new version", strings.Join(mismatches, ", "))
+	}
+
+	_, err = conn.UndeprecateActivityType(ctx, &swf.UndeprecateActivityTypeInput{
+		ActivityType: activityType,
+		Domain:       registerInput.Domain,
+	})
+
+	return err
+}
+
+func findActivityTypeByThreePartKey(ctx context.Context, conn *swf.Client, domain, name, version string) (*swf.DescribeActivityTypeOutput, error) {
+	input := &swf.DescribeActivityTypeInput{
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeActivityType(ctx, input)
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.ActivityType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.TypeInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
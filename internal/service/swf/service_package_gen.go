@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceActivityType,
+			TypeName: "aws_swf_activity_type",
+			Name:     "Activity Type",
+		},
 		{
 			Factory:  resourceDomain,
 			TypeName: "aws_swf_domain",
@@ -37,6 +42,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceWorkflowType,
+			TypeName: "aws_swf_workflow_type",
+			Name:     "Workflow Type",
+		},
 	}
 }
 
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// validateTimeout validates an SWF duration, which is either a number of seconds or "NONE".
+func validateTimeout(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if value == "NONE" {
+		return
+	}
+
+	if n, err := strconv.Atoi(value); err != nil || n < 0 {
+		es = append(es, fmt.Errorf("%q must be a non-negative number of seconds or \"NONE\", got: %s", k, value))
+	}
+
+	return
+}
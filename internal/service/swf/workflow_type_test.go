@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfswf "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSWFWorkflowType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_execution_start_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "default_lambda_role", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "default"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomain, "aws_swf_domain.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFWorkflowType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfswf.ResourceWorkflowType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFWorkflowType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTypeConfig_versionDescription(rName, "1.0", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				Config:      testAccWorkflowTypeConfig_versionDescription(rName, "1.0", "updated"),
+				ExpectError: regexache.MustCompile(`description cannot be changed without also changing version`),
+			},
+			{
+				Config: testAccWorkflowTypeConfig_versionDescription(rName, "2.0", "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2.0"),
+				),
+			},
+			// Version 1.0 was deprecated by the previous step and is undeprecated.
+			{
+				Config: testAccWorkflowTypeConfig_versionDescription(rName, "1.0", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				Config:      testAccWorkflowTypeConfig_versionDescription(rName, "2.0", "different"),
+				ExpectError: regexache.MustCompile(`registered with a different description; use a new version`),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_swf_workflow_type" {
+				continue
+			}
+
+			// Retrying as Read after Delete is not always consistent.
+			_, err := tfresource.RetryUntilNotFound(ctx, 2*time.Minute, func() (interface{}, error) {
+				return tfswf.FindWorkflowTypeByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrVersion])
+			})
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkflowTypeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		_, err := tfswf.FindWorkflowTypeByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrVersion])
+
+		return err
+	}
+}
+
+func testAccWorkflowTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  domain      = aws_swf_domain.test.name
+  name        = %[1]q
+  version     = "1.0"
+  description = "test"
+
+  default_child_policy                     = "TERMINATE"
+  default_execution_start_to_close_timeout = "3600"
+  default_task_list                        = "default"
+  default_task_start_to_close_timeout      = "300"
+}
+`, rName)
+}
+
+func testAccWorkflowTypeConfig_versionDescription(rName, version, description string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  domain      = aws_swf_domain.test.name
+  name        = %[1]q
+  version     = %[2]q
+  description = %[3]q
+
+  default_child_policy                     = "TERMINATE"
+  default_execution_start_to_close_timeout = "3600"
+  default_task_list                        = "default"
+  default_task_start_to_close_timeout      = "300"
+}
+`, rName, version, description)
+}
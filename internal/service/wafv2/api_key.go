@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	apiKeyResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_wafv2_api_key", name="API Key")
+func resourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAPIKeyCreate,
+		ReadWithoutTimeout:   resourceAPIKeyRead,
+		DeleteWithoutTimeout: resourceAPIKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAPIKeyImport,
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"api_key": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				names.AttrCreationTime: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrScope: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Scope](),
+				},
+				"token_domains": {
+					Type:     schema.TypeSet,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					MaxItems: 5,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.All(
+							validation.StringLenBetween(1, 253),
+							validation.StringMatch(regexache.MustCompile(`^[\w\.\-/]+$`), "must contain only alphanumeric, hyphen, dot, underscore and forward-slash characters"),
+						),
+					},
+				},
+			}
+		},
+	}
+}
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	scope := d.Get(names.AttrScope).(string)
+	input := &wafv2.CreateAPIKeyInput{
+		Scope:        awstypes.Scope(scope),
+		TokenDomains: flex.ExpandStringValueSet(d.Get("token_domains").(*schema.Set)),
+	}
+
+	output, err := conn.CreateAPIKey(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WAFv2 API Key: %s", err)
+	}
+
+	apiKey := aws.ToString(output.APIKey)
+	d.SetId(apiKeyCreateResourceID(apiKey, scope))
+	d.Set("api_key", apiKey)
+
+	return append(diags, resourceAPIKeyRead(ctx, d, meta)...)
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	apiKey, scope := d.Get("api_key").(string), d.Get(names.AttrScope).(string)
+	output, err := findAPIKeyByTwoPartKey(ctx, conn, apiKey, scope)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAFv2 API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 API Key (%s): %s", d.Id(), err)
+	}
+
+	if v := output.CreationTimestamp; v != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("token_domains", output.TokenDomains)
+
+	return diags
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	log.Printf("[INFO] Deleting WAFv2 API Key: %s", d.Id())
+	_, err := conn.DeleteAPIKey(ctx, &wafv2.DeleteAPIKeyInput{
+		APIKey: aws.String(d.Get("api_key").(string)),
+		Scope:  awstypes.Scope(d.Get(names.AttrScope).(string)),
+	})
+
+	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WAFv2 API Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceAPIKeyImport imports an API key using its value and scope.
+// The resource ID is derived from them so that the key itself is only stored in the sensitive api_key attribute.
+func resourceAPIKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.ExpandResourceId(d.Id(), apiKeyResourceIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, scope := parts[0], parts[1]
+	d.SetId(apiKeyCreateResourceID(apiKey, scope))
+	d.Set("api_key", apiKey)
+	d.Set(names.AttrScope, scope)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// apiKeyCreateResourceID returns an ID that identifies an API key without containing it.
+func apiKeyCreateResourceID(apiKey, scope string) string {
+	return errs.Must(flex.FlattenResourceId([]string{fmt.Sprintf("%x", sha256.Sum256([]byte(apiKey))), scope}, apiKeyResourceIDPartCount, false))
+}
+
+func findAPIKeyByTwoPartKey(ctx context.Context, conn *wafv2.Client, apiKey, scope string) (*wafv2.GetDecryptedAPIKeyOutput, error) {
+	input := &wafv2.GetDecryptedAPIKeyInput{
+		APIKey: aws.String(apiKey),
+		Scope:  awstypes.Scope(scope),
+	}
+
+	output, err := conn.GetDecryptedAPIKey(ctx, input)
+
+	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_wafv2_application_integration", name="Application Integration")
+func dataSourceApplicationIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationIntegrationRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"application_integration_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrScope: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Scope](),
+				},
+			}
+		},
+	}
+}
+
+func dataSourceApplicationIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	scope := d.Get(names.AttrScope).(string)
+	input := &wafv2.ListAPIKeysInput{
+		Limit: aws.Int32(1),
+		Scope: awstypes.Scope(scope),
+	}
+
+	output, err := conn.ListAPIKeys(ctx, input)
+
+	if err == nil && output == nil {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 Application Integration (%s): %s", scope, err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region + "," + scope)
+	d.Set("application_integration_url", output.ApplicationIntegrationURL)
+
+	return diags
+}
@@ -5,6 +5,7 @@ package wafv2
 
 // Exports for use in tests only.
 var (
+	ResourceAPIKey                     = resourceAPIKey
 	ResourceIPSet                      = resourceIPSet
 	ResourceRegexPatternSet            = resourceRegexPatternSet
 	ResourceRuleGroup                  = resourceRuleGroup
@@ -12,6 +13,7 @@ var (
 	ResourceWebACLAssociation          = resourceWebACLAssociation
 	ResourceWebACLLoggingConfiguration = resourceWebACLLoggingConfiguration
 
+	FindAPIKeyByTwoPartKey            = findAPIKeyByTwoPartKey
 	FindIPSetByThreePartKey           = findIPSetByThreePartKey
 	FindLoggingConfigurationByARN     = findLoggingConfigurationByARN
 	FindRegexPatternSetByThreePartKey = findRegexPatternSetByThreePartKey
@@ -20,4 +22,6 @@ var (
 	FindWebACLByThreePartKey          = findWebACLByThreePartKey
 	ListRuleGroupsPages               = listRuleGroupsPages
 	ListWebACLsPages                  = listWebACLsPages
+
+	SuppressEquivalentWebACLRulesJSON = suppressEquivalentWebACLRulesJSON
 )
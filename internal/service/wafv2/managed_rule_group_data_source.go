@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_wafv2_managed_rule_group", name="Managed Rule Group")
+func dataSourceManagedRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceManagedRuleGroupRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"current_default_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrScope: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Scope](),
+				},
+				"vendor_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"versions": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"last_update_timestamp": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceManagedRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	scope := d.Get(names.AttrScope).(string)
+	vendorName := d.Get("vendor_name").(string)
+	output, err := findManagedRuleGroupVersionsByThreePartKey(ctx, conn, vendorName, name, scope)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 Managed Rule Group (%s/%s) versions: %s", vendorName, name, err)
+	}
+
+	d.SetId(strings.Join([]string{vendorName, name, scope}, ","))
+	d.Set("current_default_version", output.CurrentDefaultVersion)
+	if err := d.Set("versions", flattenManagedRuleGroupVersions(output.Versions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+func findManagedRuleGroupVersionsByThreePartKey(ctx context.Context, conn *wafv2.Client, vendorName, name, scope string) (*wafv2.ListAvailableManagedRuleGroupVersionsOutput, error) {
+	input := &wafv2.ListAvailableManagedRuleGroupVersionsInput{
+		Name:       aws.String(name),
+		Scope:      awstypes.Scope(scope),
+		VendorName: aws.String(vendorName),
+	}
+
+	return findManagedRuleGroupVersions(ctx, conn, input)
+}
+
+func findManagedRuleGroupVersions(ctx context.Context, conn *wafv2.Client, input *wafv2.ListAvailableManagedRuleGroupVersionsInput) (*wafv2.ListAvailableManagedRuleGroupVersionsOutput, error) {
+	var output *wafv2.ListAvailableManagedRuleGroupVersionsOutput
+
+	for {
+		page, err := conn.ListAvailableManagedRuleGroupVersions(ctx, input)
+
+		if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.Versions = append(output.Versions, page.Versions...)
+		}
+
+		if aws.ToString(page.NextMarker) == "" {
+			break
+		}
+
+		input.NextMarker = page.NextMarker
+	}
+
+	return output, nil
+}
+
+func flattenManagedRuleGroupVersions(apiObjects []awstypes.ManagedRuleGroupVersion) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName: aws.ToString(apiObject.Name),
+		}
+
+		if v := apiObject.LastUpdateTimestamp; v != nil {
+			tfMap["last_update_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
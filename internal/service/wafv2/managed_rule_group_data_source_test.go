@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2ManagedRuleGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_wafv2_managed_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedRuleGroupDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "current_default_version"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrName, "AWSManagedRulesCommonRuleSet"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrScope, "REGIONAL"),
+					resource.TestCheckResourceAttr(datasourceName, "vendor_name", "AWS"),
+					resource.TestCheckResourceAttrSet(datasourceName, "versions.0.name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "versions.0.last_update_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+const testAccManagedRuleGroupDataSourceConfig_basic = `
+data "aws_wafv2_managed_rule_group" "test" {
+  name        = "AWSManagedRulesCommonRuleSet"
+  scope       = "REGIONAL"
+  vendor_name = "AWS"
+}
+`
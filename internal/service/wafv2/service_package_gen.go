@@ -25,11 +25,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceApplicationIntegration,
+			TypeName: "aws_wafv2_application_integration",
+			Name:     "Application Integration",
+		},
 		{
 			Factory:  dataSourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
 			Name:     "IP Set",
 		},
+		{
+			Factory:  dataSourceManagedRuleGroup,
+			TypeName: "aws_wafv2_managed_rule_group",
+			Name:     "Managed Rule Group",
+		},
 		{
 			Factory:  dataSourceRegexPatternSet,
 			TypeName: "aws_wafv2_regex_pattern_set",
@@ -50,6 +60,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAPIKey,
+			TypeName: "aws_wafv2_api_key",
+			Name:     "API Key",
+		},
 		{
 			Factory:  resourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
@@ -4,9 +4,15 @@
 package wafv2
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,15 +20,19 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -65,6 +75,10 @@ func resourceWebACL() *schema.Resource {
 					Computed: true,
 				},
 				"association_config": associationConfigSchema(),
+				"auto_version_upgrade": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				"capacity": {
 					Type:     schema.TypeInt,
 					Computed: true,
@@ -92,6 +106,11 @@ func resourceWebACL() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"managed_rule_group_versions": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -102,8 +121,9 @@ func resourceWebACL() *schema.Resource {
 					),
 				},
 				names.AttrRule: {
-					Type:     schema.TypeSet,
-					Optional: true,
+					Type:          schema.TypeSet,
+					Optional:      true,
+					ConflictsWith: []string{"rule_json"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							names.AttrAction: {
@@ -147,6 +167,18 @@ func resourceWebACL() *schema.Resource {
 						},
 					},
 				},
+				"rule_json": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ConflictsWith:    []string{names.AttrRule},
+					ValidateFunc:     validateWebACLRulesJSON,
+					DiffSuppressFunc: suppressEquivalentWebACLRulesJSON,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
 				names.AttrScope: {
 					Type:             schema.TypeString,
 					Required:         true,
@@ -170,7 +202,11 @@ func resourceWebACL() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			webACLRuleJSONCustomizeDiff,
+			webACLManagedRuleGroupVersionsCustomizeDiff,
+		),
 	}
 }
 
@@ -179,13 +215,19 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
 
 	name := d.Get(names.AttrName).(string)
+	rules, err := expandWebACLRulesFromConfig(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
 	input := &wafv2.CreateWebACLInput{
 		AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
 		CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
 		ChallengeConfig:   expandChallengeConfig(d.Get("challenge_config").([]interface{})),
 		DefaultAction:     expandDefaultAction(d.Get(names.AttrDefaultAction).([]interface{})),
 		Name:              aws.String(name),
-		Rules:             expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()),
+		Rules:             rules,
 		Scope:             awstypes.Scope(d.Get(names.AttrScope).(string)),
 		Tags:              getTagsIn(ctx),
 		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
@@ -218,6 +260,10 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(aws.ToString(output.Summary.Id))
 
+	if err := setWebACLManagedRuleGroupVersions(ctx, conn, d, rules); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	return append(diags, resourceWebACLRead(ctx, d, meta)...)
 }
 
@@ -259,10 +305,22 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrDescription, webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set(names.AttrName, webACL.Name)
-	rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
-	if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	configRules, err := expandWebACLRulesFromConfig(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
+	}
+	rules := filterWebACLRules(webACL.Rules, configRules)
+	// Rules configured via rule_json are only refreshed into rule_json. Both are populated on import.
+	if _, ok := d.GetOk("rule_json"); !ok || d.Get(names.AttrRule).(*schema.Set).Len() > 0 {
+		if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+		}
+	}
+	ruleJSON, err := flattenWebACLRulesJSON(rules)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
 	}
+	d.Set("rule_json", ruleJSON)
 	d.Set("token_domains", aws.StringSlice(webACL.TokenDomains))
 	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting visibility_config: %s", err)
@@ -275,13 +333,18 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("auto_version_upgrade", "managed_rule_group_versions", names.AttrTags, names.AttrTagsAll) {
 		aclName := d.Get(names.AttrName).(string)
 		aclScope := d.Get(names.AttrScope).(string)
 		aclLockToken := d.Get("lock_token").(string)
 		// Find the AWS managed ShieldMitigationRuleGroup group rule if existent and add it into the set of rules to update
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
-		rules := expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+		rules, err := expandWebACLRulesFromConfig(d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
+
 		if sr := findShieldRule(rules); len(sr) == 0 {
 			output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
 
@@ -320,7 +383,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		const (
 			timeout = 5 * time.Minute
 		)
-		_, err := tfresource.RetryWhenIsA[*awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenIsA[*awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
 			return conn.UpdateWebACL(ctx, input)
 		})
 
@@ -350,6 +413,18 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChanges("auto_version_upgrade", "managed_rule_group_versions", names.AttrRule, "rule_json") {
+		rules, err := expandWebACLRulesFromConfig(d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
+
+		if err := setWebACLManagedRuleGroupVersions(ctx, conn, d, rules); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceWebACLRead(ctx, d, meta)...)
 }
 
@@ -434,6 +509,286 @@ func findWebACLByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name,
 	return output, nil
 }
 
+func webACLManagedRuleGroupVersionsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Versions are no longer recorded once auto_version_upgrade is switched off.
+	if !d.Get("auto_version_upgrade").(bool) {
+		if len(d.Get("managed_rule_group_versions").(map[string]interface{})) > 0 {
+			return d.SetNewComputed("managed_rule_group_versions")
+		}
+
+		return nil
+	}
+
+	if d.HasChanges("auto_version_upgrade", names.AttrRule, "rule_json") {
+		return d.SetNewComputed("managed_rule_group_versions")
+	}
+
+	rules, err := expandWebACLRulesFromConfig(d)
+
+	if err != nil {
+		return err
+	}
+
+	// Plan a change when AWS has changed the default version of an unpinned managed rule group since the last apply.
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+	versions, err := findWebACLManagedRuleGroupVersions(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	// Don't prevent planning if the available versions can't be listed, e.g. due to missing permissions or throttling.
+	if err != nil {
+		tflog.Warn(ctx, "Unable to check WAFv2 WebACL managed rule group versions, skipping", map[string]any{
+			"web_acl_id": d.Id(),
+			"error":      err.Error(),
+		})
+
+		return nil
+	}
+
+	if !maps.Equal(versions, flex.ExpandStringValueMap(d.Get("managed_rule_group_versions").(map[string]interface{}))) {
+		return d.SetNew("managed_rule_group_versions", versions)
+	}
+
+	return nil
+}
+
+// setWebACLManagedRuleGroupVersions records the versions of the web ACL's managed rule groups resolved at apply time.
+func setWebACLManagedRuleGroupVersions(ctx context.Context, conn *wafv2.Client, d *schema.ResourceData, rules []awstypes.Rule) error {
+	if !d.Get("auto_version_upgrade").(bool) {
+		d.Set("managed_rule_group_versions", nil)
+
+		return nil
+	}
+
+	versions, err := findWebACLManagedRuleGroupVersions(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	if err != nil {
+		return fmt.Errorf("reading WAFv2 WebACL (%s) managed rule group versions: %w", d.Id(), err)
+	}
+
+	d.Set("managed_rule_group_versions", versions)
+
+	return nil
+}
+
+// findWebACLManagedRuleGroupVersions returns a map of rule name to the version used by the rule's managed rule group statement.
+// Rule names are unique within a web ACL, whereas the same managed rule group may be used by several rules.
+// Unpinned managed rule groups resolve to the current default version.
+func findWebACLManagedRuleGroupVersions(ctx context.Context, conn *wafv2.Client, scope string, rules []awstypes.Rule) (map[string]string, error) {
+	versions := make(map[string]string)
+
+	for _, rule := range rules {
+		if rule.Statement == nil || rule.Statement.ManagedRuleGroupStatement == nil {
+			continue
+		}
+
+		statement := rule.Statement.ManagedRuleGroupStatement
+		vendorName, name := aws.ToString(statement.VendorName), aws.ToString(statement.Name)
+		key := aws.ToString(rule.Name)
+
+		if v := aws.ToString(statement.Version); v != "" {
+			versions[key] = v
+			continue
+		}
+
+		output, err := findManagedRuleGroupVersionsByThreePartKey(ctx, conn, vendorName, name, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		versions[key] = aws.ToString(output.CurrentDefaultVersion)
+	}
+
+	return versions, nil
+}
+
+// webACLRuleJSONCustomizeDiff marks the computed rule_json as changing when the rules are configured via rule blocks.
+func webACLRuleJSONCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.GetRawConfig().GetAttr("rule_json").IsNull() && d.HasChange(names.AttrRule) {
+		return d.SetNewComputed("rule_json")
+	}
+
+	return nil
+}
+
+func expandWebACLRulesFromConfig(d sdkv2.ResourceDiffer) ([]awstypes.Rule, error) {
+	// rule_json is also computed from the rule blocks, so it is only authoritative when there are no rule blocks.
+	if v := d.Get(names.AttrRule).(*schema.Set); v.Len() > 0 {
+		return expandWebACLRules(v.List()), nil
+	}
+
+	return expandWebACLRulesJSON(d.Get("rule_json").(string))
+}
+
+// expandWebACLRulesJSON decodes a JSON array of rules, in the format used by the WAF console and API.
+// Keys that are not part of the API's Rule structure are rejected.
+func expandWebACLRulesJSON(rawRules string) ([]awstypes.Rule, error) {
+	if rawRules == "" {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	if err := json.Unmarshal([]byte(rawRules), &tfList); err != nil {
+		return nil, fmt.Errorf("decoding rule_json: %w", err)
+	}
+
+	// The API represents byte match search strings as plain text whereas encoding/json expects []byte fields to be base64-encoded.
+	for _, v := range tfList {
+		walkWebACLRuleJSON(v)
+	}
+
+	b, err := json.Marshal(tfList)
+
+	if err != nil {
+		return nil, fmt.Errorf("encoding rule_json: %w", err)
+	}
+
+	var apiObjects []awstypes.Rule
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&apiObjects); err != nil {
+		return nil, fmt.Errorf("decoding rule_json: %w", err)
+	}
+
+	for i, apiObject := range apiObjects {
+		if aws.ToString(apiObject.Name) == "" {
+			return nil, fmt.Errorf("decoding rule_json: rule at index %d has no Name", i)
+		}
+	}
+
+	return apiObjects, nil
+}
+
+func walkWebACLRuleJSON(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if key == "ByteMatchStatement" {
+				if tfMap, ok := value.(map[string]interface{}); ok {
+					if s, ok := tfMap["SearchString"].(string); ok {
+						tfMap["SearchString"] = []byte(s)
+					}
+				}
+			}
+
+			walkWebACLRuleJSON(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			walkWebACLRuleJSON(value)
+		}
+	}
+}
+
+// flattenWebACLRulesJSON encodes rules as a JSON array in the format used by the WAF console and API.
+// Unset fields are omitted and byte match search strings are plain text, as accepted by expandWebACLRulesJSON.
+func flattenWebACLRulesJSON(apiObjects []awstypes.Rule) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(apiObjects)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding rule_json: %w", err)
+	}
+
+	var tfList []interface{}
+
+	if err := json.Unmarshal(b, &tfList); err != nil {
+		return "", fmt.Errorf("encoding rule_json: %w", err)
+	}
+
+	for i, v := range tfList {
+		tfList[i] = cleanWebACLRuleJSON(v)
+	}
+
+	b, err = json.Marshal(tfList)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding rule_json: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// cleanWebACLRuleJSON removes null and empty string values and decodes base64-encoded byte match search strings.
+func cleanWebACLRuleJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value == nil || value == "" {
+				delete(v, key)
+				continue
+			}
+
+			if key == "ByteMatchStatement" {
+				if tfMap, ok := value.(map[string]interface{}); ok {
+					if s, ok := tfMap["SearchString"].(string); ok {
+						if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+							tfMap["SearchString"] = string(b)
+						}
+					}
+				}
+			}
+
+			v[key] = cleanWebACLRuleJSON(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = cleanWebACLRuleJSON(value)
+		}
+	}
+
+	return v
+}
+
+func validateWebACLRulesJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandWebACLRulesJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// suppressEquivalentWebACLRulesJSON suppresses differences between rule_json values that decode to the same rules.
+// The API does not preserve the order of rules, so rules are compared in priority order.
+func suppressEquivalentWebACLRulesJSON(k, old, new string, d *schema.ResourceData) bool {
+	oldRules, err := expandWebACLRulesJSON(old)
+	if err != nil {
+		return false
+	}
+
+	newRules, err := expandWebACLRulesJSON(new)
+	if err != nil {
+		return false
+	}
+
+	byPriority := func(a, b awstypes.Rule) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	}
+	slices.SortStableFunc(oldRules, byPriority)
+	slices.SortStableFunc(newRules, byPriority)
+
+	oldJSON, err := json.Marshal(oldRules)
+	if err != nil {
+		return false
+	}
+
+	newJSON, err := json.Marshal(newRules)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(oldJSON, newJSON)
+}
+
 // filterWebACLRules removes the AWS-added Shield Advanced auto mitigation rule here
 // so that the provider will not report diff and/or attempt to remove the rule as it is
 // owned and managed by AWS.
@@ -35,6 +35,58 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestSuppressEquivalentWebACLRulesJSON(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rule1 = `{"Name":"rule-1","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-1","SampledRequestsEnabled":false}}`
+		rule2 = `{"Name":"rule-2","Priority":2,"Action":{"Count":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["CA"]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-2","SampledRequestsEnabled":false}}`
+		rule3 = `{"Name":"rule-3","Priority":3,"Action":{"Count":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["MX"]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-3","SampledRequestsEnabled":false}}`
+	)
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "same order",
+			old:      "[" + rule1 + "," + rule2 + "]",
+			new:      "[" + rule1 + "," + rule2 + "]",
+			expected: true,
+		},
+		{
+			name:     "different order",
+			old:      "[" + rule2 + "," + rule3 + "," + rule1 + "]",
+			new:      "[" + rule1 + "," + rule2 + "," + rule3 + "]",
+			expected: true,
+		},
+		{
+			name: "different rules",
+			old:  "[" + rule1 + "," + rule2 + "]",
+			new:  "[" + rule1 + "," + rule3 + "]",
+		},
+		{
+			name: "added rule",
+			old:  "[" + rule2 + "," + rule1 + "]",
+			new:  "[" + rule1 + "," + rule2 + "," + rule3 + "]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfwafv2.SuppressEquivalentWebACLRulesJSON("rule_json", testCase.old, testCase.new, nil), testCase.expected; got != want {
+				t.Errorf("SuppressEquivalentWebACLRulesJSON = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestAccWAFV2WebACL_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.WebACL
@@ -1050,6 +1102,86 @@ func TestAccWAFV2WebACL_minimal(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_ruleJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_ruleJSON(webACLName, "badbot"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					testAccCheckWebACLByteMatchSearchString(&v, "badbot"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct0),
+					resource.TestMatchResourceAttr(resourceName, "rule_json", regexache.MustCompile(`"SearchString":"badbot"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLImportStateIdFunc(resourceName),
+				// Import also populates rule blocks as it cannot tell how the rules are configured.
+				ImportStateVerifyIgnore: []string{"rule."},
+			},
+			{
+				Config: testAccWebACLConfig_ruleJSON(webACLName, "worsebot"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					testAccCheckWebACLByteMatchSearchString(&v, "worsebot"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct0),
+					resource.TestMatchResourceAttr(resourceName, "rule_json", regexache.MustCompile(`"SearchString":"worsebot"`)),
+				),
+			},
+			{
+				Config:      testAccWebACLConfig_ruleJSONUnknownKey(webACLName),
+				ExpectError: regexache.MustCompile(`unknown field "RuleName"`),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACL_ManagedRuleGroup_autoVersionUpgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+	datasourceName := "data.aws_wafv2_managed_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_managedRuleGroupStatementAutoVersionUpgrade(webACLName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_version_upgrade", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule_group_versions.%", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "managed_rule_group_versions.rule-1", datasourceName, "current_default_version"),
+				),
+			},
+			{
+				Config: testAccWebACLConfig_managedRuleGroupStatementAutoVersionUpgrade(webACLName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_version_upgrade", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule_group_versions.%", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2WebACL_RateBased_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.WebACL
@@ -3018,6 +3150,25 @@ func TestAccWAFV2WebACL_CloudFrontScope(t *testing.T) {
 	})
 }
 
+func testAccCheckWebACLByteMatchSearchString(v *awstypes.WebACL, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(v.Rules) != 1 {
+			return fmt.Errorf("expected 1 rule, got %d", len(v.Rules))
+		}
+
+		statement := v.Rules[0].Statement
+		if statement == nil || statement.ByteMatchStatement == nil {
+			return fmt.Errorf("expected ByteMatchStatement")
+		}
+
+		if got := string(statement.ByteMatchStatement.SearchString); got != want {
+			return fmt.Errorf("expected SearchString %q, got %q", want, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckWebACLDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
@@ -4126,6 +4277,54 @@ resource "aws_wafv2_web_acl" "test" {
 `, rName, fallbackBehavior, headerName, position)
 }
 
+func testAccWebACLConfig_managedRuleGroupStatementAutoVersionUpgrade(rName string, autoVersionUpgrade bool) string {
+	return fmt.Sprintf(`
+data "aws_wafv2_managed_rule_group" "test" {
+  name        = "AWSManagedRulesCommonRuleSet"
+  scope       = "REGIONAL"
+  vendor_name = "AWS"
+}
+
+resource "aws_wafv2_web_acl" "test" {
+  name                 = %[1]q
+  scope                = "REGIONAL"
+  auto_version_upgrade = %[2]t
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    override_action {
+      none {}
+    }
+
+    statement {
+      managed_rule_group_statement {
+        name        = "AWSManagedRulesCommonRuleSet"
+        vendor_name = "AWS"
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, autoVersionUpgrade)
+}
+
 func testAccWebACLConfig_managedRuleGroupStatement(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
@@ -5561,6 +5760,78 @@ resource "aws_wafv2_web_acl" "test" {
 `, rName)
 }
 
+func testAccWebACLConfig_ruleJSON(rName, searchString string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    Priority = 1
+    Action = {
+      Block = {}
+    }
+    Statement = {
+      ByteMatchStatement = {
+        SearchString = %[2]q
+        FieldToMatch = {
+          SingleHeader = {
+            Name = "user-agent"
+          }
+        }
+        TextTransformations = [{
+          Priority = 0
+          Type     = "NONE"
+        }]
+        PositionalConstraint = "CONTAINS"
+      }
+    }
+    VisibilityConfig = {
+      CloudWatchMetricsEnabled = false
+      MetricName               = "friendly-rule-metric-name"
+      SampledRequestsEnabled   = false
+    }
+  }])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, searchString)
+}
+
+func testAccWebACLConfig_ruleJSONUnknownKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    RuleName = "rule-1"
+    Priority = 1
+  }])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
+
 func testAccWebACLConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,6 +34,10 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes contains resource type names (e.g. "aws_secretsmanager_secret") to which no default tags are applied.
+	ExcludeResourceTypes []string
+	// ExcludeServices contains service package names (e.g. "secretsmanager") to whose resources no default tags are applied.
+	ExcludeServices []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -47,6 +52,20 @@ type IgnoreConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// ForResource returns the DefaultConfig applicable to the specified resource type,
+// or nil if the resource type or its service is excluded from default tags.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if slices.Contains(dc.ExcludeServices, servicePackageName) || slices.Contains(dc.ExcludeResourceTypes, typeName) {
+		return nil
+	}
+
+	return dc
+}
+
 // GetTags is convenience method that returns the DefaultConfig's Tags, if any
 func (dc *DefaultConfig) GetTags() KeyValueTags {
 	if dc == nil {
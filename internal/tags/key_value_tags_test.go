@@ -57,6 +57,66 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		ExcludeResourceTypes: []string{"aws_secretsmanager_secret"},
+		ExcludeServices:      []string{"ec2"},
+	}
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               KeyValueTags
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               nil,
+		},
+		{
+			name:               "not excluded",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "secretsmanager",
+			typeName:           "aws_secretsmanager_secret_version",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "secretsmanager",
+			typeName:           "aws_secretsmanager_secret",
+			want:               nil,
+		},
+		{
+			name:               "excluded service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want:               nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName).GetTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want.Map())
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
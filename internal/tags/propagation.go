@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultPropagationTimeout = 5 * time.Minute
+)
+
+// PropagationConfig configures how WaitPropagated polls for tag propagation.
+// Each service package that needs to wait supplies its own configuration (see the tags generator's -Wait* flags).
+type PropagationConfig struct {
+	ContinuousTargetOccurence int           // Number of times the desired tags have to be observed continuously.
+	Delay                     time.Duration // Wait this time before starting checks.
+	MinTimeout                time.Duration // Smallest time to wait before refreshes.
+	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
+	Timeout                   time.Duration // Defaults to 5 minutes.
+	ExactMatch                bool          // Require the observed tags to equal the desired tags, rather than just contain them.
+}
+
+// ListTagsFunc returns the tags currently observed on a resource.
+type ListTagsFunc func(context.Context) (KeyValueTags, error)
+
+// WaitPropagated waits until the tags returned by listTags reflect a change from oldTags to newTags:
+// every tag in newTags is present with its new value and every tag removed from oldTags is absent.
+// Services with eventually consistent tagging APIs can return stale tags immediately after
+// tags are created or updated, which would otherwise surface as spurious differences on the next plan.
+// Observed tags not managed via oldTags or newTags (e.g. AWS system tags, or tags managed by
+// another resource) are not compared, and neither are tags ignored via the provider's ignore_tags configuration.
+// If config.ExactMatch is set, the observed tags must instead equal newTags exactly (ignored tags excepted).
+// A resource that is not yet found is treated as not yet propagated.
+func WaitPropagated(ctx context.Context, oldTags, newTags KeyValueTags, listTags ListTagsFunc, config PropagationConfig) error {
+	removedTags := oldTags.Removed(newTags)
+
+	var ignoreConfig *IgnoreConfig
+	if inContext, ok := FromContext(ctx); ok {
+		ignoreConfig = inContext.IgnoreConfig
+		newTags = newTags.IgnoreConfig(ignoreConfig)
+		removedTags = removedTags.IgnoreConfig(ignoreConfig)
+	}
+
+	tflog.Debug(ctx, "Waiting for tag propagation", map[string]any{
+		names.AttrTags: newTags,
+		"removed_tags": removedTags.Keys(),
+	})
+
+	checkFunc := func() (bool, error) {
+		output, err := listTags(ctx)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if config.ExactMatch {
+			return output.IgnoreConfig(ignoreConfig).Equal(newTags), nil
+		}
+
+		if !output.ContainsAll(newTags) {
+			return false, nil
+		}
+
+		for k := range removedTags {
+			if output.KeyExists(k) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: config.ContinuousTargetOccurence,
+		Delay:                     config.Delay,
+		MinTimeout:                config.MinTimeout,
+		PollInterval:              config.PollInterval,
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = defaultPropagationTimeout
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
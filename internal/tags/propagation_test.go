@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func TestWaitPropagated(t *testing.T) {
+	t.Parallel()
+
+	oldTags := New(context.Background(), map[string]string{
+		"key1": "value1",
+		"key2": "old",
+		"key3": "value3",
+	})
+	newTags := New(context.Background(), map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+	errList := errors.New("list error")
+
+	testCases := []struct {
+		name          string
+		ignoreConfig  *IgnoreConfig
+		exactMatch    bool
+		observed      []KeyValueTags
+		listErrs      []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name: "already propagated",
+			observed: []KeyValueTags{
+				newTags,
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "stale then propagated",
+			observed: []KeyValueTags{
+				oldTags,
+				New(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"}),
+				newTags,
+			},
+			expectedCalls: 3,
+		},
+		{
+			name: "unmanaged tags",
+			observed: []KeyValueTags{
+				New(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key4": "value4", "aws:cloudformation:stack-name": "stack"}),
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:       "exact match unmanaged tags",
+			exactMatch: true,
+			observed: []KeyValueTags{
+				New(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key4": "value4"}),
+				newTags,
+			},
+			expectedCalls: 2,
+		},
+		{
+			name:       "exact match ignored tags",
+			exactMatch: true,
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(context.Background(), []string{"key4"}),
+			},
+			observed: []KeyValueTags{
+				New(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key4": "value4"}),
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "not found then propagated",
+			observed: []KeyValueTags{
+				nil,
+				newTags,
+			},
+			listErrs: []error{
+				&retry.NotFoundError{},
+				nil,
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "ignored tags",
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(context.Background(), []string{"key3"}),
+			},
+			observed: []KeyValueTags{
+				New(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"}),
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "list error",
+			observed: []KeyValueTags{
+				nil,
+			},
+			listErrs: []error{
+				errList,
+			},
+			expectedCalls: 1,
+			expectedErr:   errList,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.ignoreConfig != nil {
+				ctx = NewContext(ctx, nil, testCase.ignoreConfig)
+			}
+
+			calls := 0
+			listTags := func(context.Context) (KeyValueTags, error) {
+				i := min(calls, len(testCase.observed)-1)
+				calls++
+
+				var err error
+				if i < len(testCase.listErrs) {
+					err = testCase.listErrs[i]
+				}
+
+				return testCase.observed[i], err
+			}
+
+			err := WaitPropagated(ctx, oldTags, newTags, listTags, PropagationConfig{
+				ExactMatch:   testCase.exactMatch,
+				PollInterval: 10 * time.Millisecond,
+				Timeout:      5 * time.Second,
+			})
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("got error %v, expected %v", err, testCase.expectedErr)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("got %d calls, expected %d", calls, testCase.expectedCalls)
+			}
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
@@ -24,29 +23,10 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return PolicyStringsEquivalent(old, new)
 }
 
+// PolicyStringsEquivalent returns whether two JSON strings representing IAM policies are semantically equivalent.
+// See tfjson.PolicyStringsEquivalent.
 func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
-
-	return equivalent
+	return tfjson.PolicyStringsEquivalent(s1, s2)
 }
 
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
@@ -142,7 +122,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := tfjson.PoliciesAreEquivalent(old, new)
 
 	if err != nil {
 		return "", err
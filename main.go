@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/apistats"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +39,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns when Terraform shuts the provider down.
+	if err := apistats.Write(); err != nil {
+		log.Printf("[WARN] writing diagnostics summary: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}